@@ -0,0 +1,189 @@
+package graphiti
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// rrfK damps the weight of top ranks in reciprocal rank fusion; 60 is the
+// value from the original paper and works well without tuning
+const rrfK = 60
+
+// SearchStrategy is one of the advanced searches run by AggregateSearch.
+// Create strategies with TemporalStrategy, DiverseStrategy and the other
+// constructors.
+type SearchStrategy struct {
+	// Name identifies the strategy in AggregatedItem.Strategies and PartialError
+	Name string
+	run  func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error)
+}
+
+// TemporalStrategy runs a temporal window search between start and end
+func TemporalStrategy(start, end time.Time) SearchStrategy {
+	return SearchStrategy{Name: "temporal", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.temporalWindowSearch(ctx, TemporalSearchRequest{Query: query, GroupID: groupID, TimeStart: start, TimeEnd: end}))
+	}}
+}
+
+// DiverseStrategy runs a diverse results search; an empty level uses the server default
+func DiverseStrategy(level DiversityLevel) SearchStrategy {
+	return SearchStrategy{Name: "diverse", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.diverseResultsSearch(ctx, DiverseSearchRequest{Query: query, GroupID: groupID, DiversityLevel: level}))
+	}}
+}
+
+// RecentStrategy runs a recent context search over the given window; zero uses the server default
+func RecentStrategy(within time.Duration) SearchStrategy {
+	return SearchStrategy{Name: "recent", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.recentContextSearch(ctx, RecentContextSearchRequest{Query: query, GroupID: groupID, RecencyDuration: within}))
+	}}
+}
+
+// EpisodeContextStrategy runs an episode context search
+func EpisodeContextStrategy() SearchStrategy {
+	return SearchStrategy{Name: "episode_context", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.episodeContextSearch(ctx, EpisodeContextSearchRequest{Query: query, GroupID: groupID}))
+	}}
+}
+
+// SuccessfulToolsStrategy runs a successful tools search
+func SuccessfulToolsStrategy() SearchStrategy {
+	return SearchStrategy{Name: "successful_tools", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.successfulToolsSearch(ctx, SuccessfulToolsSearchRequest{Query: query, GroupID: groupID}))
+	}}
+}
+
+// EntityByLabelStrategy runs an entity by label search for the labels
+func EntityByLabelStrategy(labels ...string) SearchStrategy {
+	return SearchStrategy{Name: "entity_by_label", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.entityByLabelSearch(ctx, EntityByLabelSearchRequest{Query: query, GroupID: groupID, NodeLabels: labels}))
+	}}
+}
+
+// CommunityStrategy runs a community search
+func CommunityStrategy() SearchStrategy {
+	return SearchStrategy{Name: "communities", run: func(ctx context.Context, c *Client, query string, groupID *string) (SearchResponse, error) {
+		return searchResponse(c.communitySearch(ctx, CommunitySearchRequest{Query: query, GroupID: groupID}))
+	}}
+}
+
+// searchResponse converts a typed search result to SearchResponse without
+// turning a nil pointer into a non-nil interface
+func searchResponse[R SearchResponse](response R, err error) (SearchResponse, error) {
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// AggregatedItem is a deduplicated search result. Score is its fused score
+// and Strategies lists the strategies that returned it, in the order given.
+type AggregatedItem struct {
+	ScoredItem
+	Strategies []string `json:"strategies"`
+}
+
+// AggregatedResults holds the results of AggregateSearch sorted by fused score
+type AggregatedResults struct {
+	Items []AggregatedItem `json:"items"`
+}
+
+// Edges returns the aggregated edges in ranking order
+func (r *AggregatedResults) Edges() []EdgeResult {
+	return aggregatedPayloads[EdgeResult](r.Items, ItemTypeEdge)
+}
+
+// Nodes returns the aggregated nodes in ranking order
+func (r *AggregatedResults) Nodes() []NodeResult {
+	return aggregatedPayloads[NodeResult](r.Items, ItemTypeNode)
+}
+
+// Episodes returns the aggregated episodes in ranking order
+func (r *AggregatedResults) Episodes() []EpisodeResult {
+	return aggregatedPayloads[EpisodeResult](r.Items, ItemTypeEpisode)
+}
+
+func aggregatedPayloads[T any](items []AggregatedItem, itemType string) []T {
+	var payloads []T
+	for _, item := range items {
+		if payload, ok := item.Payload.(T); ok && item.Type == itemType {
+			payloads = append(payloads, payload)
+		}
+	}
+	return payloads
+}
+
+// AggregateSearch runs the strategies concurrently for query and merges their
+// results into one ranking. Items returned by several strategies are
+// deduplicated by type and UUID and scored with reciprocal rank fusion: each
+// strategy adds 1/(60+rank), where rank is the item's position among the
+// results of the same type from that strategy. An empty groupID uses the
+// default group. If some strategies fail, the results of the others are
+// returned together with a *PartialError keyed by strategy name.
+func (c *Client) AggregateSearch(ctx context.Context, query string, groupID string, strategies ...SearchStrategy) (*AggregatedResults, error) {
+	if query == "" {
+		return nil, invalidf("query is required")
+	}
+	if len(strategies) == 0 {
+		return nil, invalidf("at least one search strategy is required")
+	}
+
+	var group *string
+	if groupID != "" {
+		group = &groupID
+	}
+
+	var (
+		wg        sync.WaitGroup
+		responses = make([]SearchResponse, len(strategies))
+		errs      = make([]error, len(strategies))
+	)
+	for i, strategy := range strategies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			responses[i], errs[i] = strategy.run(ctx, c, query, group)
+		}()
+	}
+	wg.Wait()
+
+	var (
+		failures = make(map[string]error)
+		index    = make(map[string]int)
+		items    []AggregatedItem
+	)
+	for i, response := range responses {
+		if errs[i] != nil {
+			failures[strategies[i].Name] = errs[i]
+			continue
+		}
+
+		ranks := make(map[string]int)
+		for _, item := range response.UnifiedResults() {
+			ranks[item.Type]++
+			score := 1 / float64(rrfK+ranks[item.Type])
+
+			key := item.Type + ":" + item.UUID
+			if j, ok := index[key]; ok {
+				items[j].Score += score
+				items[j].Strategies = append(items[j].Strategies, strategies[i].Name)
+				continue
+			}
+			index[key] = len(items)
+			item.Score = score
+			items = append(items, AggregatedItem{ScoredItem: item, Strategies: []string{strategies[i].Name}})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Score > items[j].Score
+	})
+
+	results := &AggregatedResults{Items: items}
+	if len(failures) > 0 {
+		return results, &PartialError{Failures: failures}
+	}
+	return results, nil
+}
@@ -0,0 +1,96 @@
+package graphiti
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxBodySnippet caps how much of an undecodable response body is quoted in errors
+const maxBodySnippet = 256
+
+// bufferBodyKey is the context key that makes sendOnce read the whole body
+type bufferBodyKey struct{}
+
+// withBufferedBody returns a context whose responses are read completely
+// within the retry loop, so a body cut off in transit is retried
+func withBufferedBody(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bufferBodyKey{}, true)
+}
+
+func bodyBuffered(ctx context.Context) bool {
+	buffered, _ := ctx.Value(bufferBodyKey{}).(bool)
+	return buffered
+}
+
+// memoryBody is a response body that has been read into memory. It keeps
+// the buffer so sendBuffered can return it without copying.
+type memoryBody struct {
+	*bytes.Reader
+	raw []byte
+}
+
+func (b *memoryBody) Close() error { return nil }
+
+// bufferBody reads the whole response body into memory and replaces
+// resp.Body with a reader over it. A failed read or a JSON document that ends
+// prematurely is reported as a transport error, which the retry policy retries.
+func bufferBody(resp *http.Response) ([]byte, error) {
+	raw, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if len(raw) > 0 && truncatedJSON(raw) {
+		return nil, fmt.Errorf("failed to read response: body cut off after %d bytes (body: %s): %w",
+			len(raw), bodySnippet(raw), io.ErrUnexpectedEOF)
+	}
+	resp.Body = &memoryBody{Reader: bytes.NewReader(raw), raw: raw}
+	return raw, nil
+}
+
+// truncatedJSON reports whether raw starts a JSON value that is never completed.
+// Valid documents are accepted by json.Valid without allocating; only invalid
+// ones are decoded to tell truncation apart from other syntax errors.
+func truncatedJSON(raw []byte) bool {
+	if json.Valid(raw) {
+		return false
+	}
+	var value json.RawMessage
+	err := json.NewDecoder(bytes.NewReader(raw)).Decode(&value)
+	return errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// sendBuffered performs a request like send and returns the whole response body
+func (c *Client) sendBuffered(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	resp, err := c.send(withBufferedBody(ctx), method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if memory, ok := resp.Body.(*memoryBody); ok {
+		return memory.raw, nil
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// decodeResponse decodes a response body, quoting the start of the body in the
+// error if it cannot be decoded
+func (c *Client) decodeResponse(raw []byte, result interface{}) error {
+	if err := c.newDecoder(bytes.NewReader(raw)).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode response: %w (body: %s)", err, bodySnippet(raw))
+	}
+	return nil
+}
+
+// bodySnippet quotes the start of a response body for error messages
+func bodySnippet(raw []byte) string {
+	if len(raw) > maxBodySnippet {
+		return fmt.Sprintf("%q... (%d bytes)", raw[:maxBodySnippet], len(raw))
+	}
+	return fmt.Sprintf("%q", raw)
+}
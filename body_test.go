@@ -0,0 +1,55 @@
+package graphiti_test
+
+import (
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestTruncatedResponseIsRetried(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	var attempts int32
+	server.Handle(graphititest.RouteHealthCheck, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			_, _ = w.Write([]byte(`{"status": "hea`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "healthy"}`))
+	})
+
+	health, err := server.Client(graphiti.WithRetry(2, time.Millisecond)).HealthCheck()
+	if err != nil {
+		t.Fatalf("HealthCheck: %v", err)
+	}
+	if health.Status != "healthy" {
+		t.Errorf("Status = %q, want healthy", health.Status)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
+
+func TestUndecodableResponseQuotesBody(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	server.Handle(graphititest.RouteHealthCheck, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`<html>bad gateway</html>`))
+	})
+
+	_, err := server.Client().HealthCheck()
+	if err == nil {
+		t.Fatal("HealthCheck succeeded on an HTML body")
+	}
+	if want := `"<html>bad gateway</html>"`; !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not quote the body %s", err, want)
+	}
+}
@@ -0,0 +1,87 @@
+package graphiti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting the server while the circuit
+// breaker set with WithCircuitBreaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// WithCircuitBreaker stops sending requests after failureThreshold consecutive
+// failures. For openDuration every request fails fast with ErrCircuitOpen;
+// then a single trial request is let through, which closes the breaker if it
+// succeeds and reopens it otherwise. Network errors, timeouts and 5xx
+// responses count as failures, after retries; other API errors and canceled
+// contexts do not. The client and its OnBehalfOf copies share the breaker.
+func WithCircuitBreaker(failureThreshold int, openDuration time.Duration) ClientOption {
+	return func(c *Client) {
+		if failureThreshold > 0 {
+			c.breaker = &circuitBreaker{threshold: failureThreshold, openDuration: openDuration}
+		}
+	}
+}
+
+// circuitBreaker counts consecutive failures shared by all copies of a client
+type circuitBreaker struct {
+	threshold    int
+	openDuration time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// allow returns ErrCircuitOpen if the request must not be sent
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+	if wait := b.openDuration - time.Since(b.openedAt); wait > 0 {
+		return fmt.Errorf("%w: retry in %s", ErrCircuitOpen, wait.Round(time.Millisecond))
+	}
+	if b.trial {
+		return fmt.Errorf("%w: trial request in flight", ErrCircuitOpen)
+	}
+	b.trial = true
+	return nil
+}
+
+// record updates the breaker with the outcome of a request let through by allow
+func (b *circuitBreaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+	switch {
+	case err == nil:
+		b.failures = 0
+	case breakerFailure(ctx, err):
+		b.failures++
+		if b.failures >= b.threshold {
+			b.openedAt = time.Now()
+		}
+	}
+}
+
+// breakerFailure reports whether err indicates an unhealthy server rather
+// than a bad request or a caller that gave up
+func breakerFailure(ctx context.Context, err error) bool {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,485 @@
+package graphiti
+
+import (
+	"context"
+	"time"
+)
+
+// The search builders assemble advanced search requests fluently, handling
+// the optional pointer fields, e.g.
+//
+//	result, err := graphiti.NewTemporalSearch("exploitation attempts").
+//		Group(groupID).
+//		Window(start, end).
+//		Max(10).
+//		Do(ctx, client)
+//
+// Request returns the assembled request for use with the client methods.
+// Builders are not safe for concurrent use.
+
+// TemporalSearchBuilder builds a TemporalSearchRequest
+type TemporalSearchBuilder struct {
+	request TemporalSearchRequest
+}
+
+// NewTemporalSearch starts a temporal window search for query
+func NewTemporalSearch(query string) *TemporalSearchBuilder {
+	return &TemporalSearchBuilder{request: TemporalSearchRequest{Query: query}}
+}
+
+// Group restricts the search to a group
+func (b *TemporalSearchBuilder) Group(groupID string) *TemporalSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *TemporalSearchBuilder) Groups(groupIDs ...string) *TemporalSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// Window sets the time window to search
+func (b *TemporalSearchBuilder) Window(start, end time.Time) *TemporalSearchBuilder {
+	b.request.TimeStart = start
+	b.request.TimeEnd = end
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *TemporalSearchBuilder) Max(n int) *TemporalSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *TemporalSearchBuilder) QueryExpansion(enabled bool) *TemporalSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *TemporalSearchBuilder) Observe(observation *Observation) *TemporalSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *TemporalSearchBuilder) Request() TemporalSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *TemporalSearchBuilder) Do(ctx context.Context, client *Client) (*TemporalSearchResponse, error) {
+	return client.temporalWindowSearch(ctx, b.request)
+}
+
+// EntityRelationshipsSearchBuilder builds an EntityRelationshipSearchRequest
+type EntityRelationshipsSearchBuilder struct {
+	request EntityRelationshipSearchRequest
+}
+
+// NewEntityRelationshipsSearch starts a relationship search around a center node
+func NewEntityRelationshipsSearch(centerNodeUUID string) *EntityRelationshipsSearchBuilder {
+	return &EntityRelationshipsSearchBuilder{request: EntityRelationshipSearchRequest{CenterNodeUUID: centerNodeUUID}}
+}
+
+// Query sets the query used to rank the relationships
+func (b *EntityRelationshipsSearchBuilder) Query(query string) *EntityRelationshipsSearchBuilder {
+	b.request.Query = query
+	return b
+}
+
+// Group restricts the search to a group
+func (b *EntityRelationshipsSearchBuilder) Group(groupID string) *EntityRelationshipsSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *EntityRelationshipsSearchBuilder) Groups(groupIDs ...string) *EntityRelationshipsSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// Depth sets the maximum number of hops from the center node
+func (b *EntityRelationshipsSearchBuilder) Depth(n int) *EntityRelationshipsSearchBuilder {
+	b.request.MaxDepth = n
+	return b
+}
+
+// Labels keeps only nodes with one of the labels
+func (b *EntityRelationshipsSearchBuilder) Labels(labels ...string) *EntityRelationshipsSearchBuilder {
+	b.request.NodeLabels = &labels
+	return b
+}
+
+// EdgeTypes keeps only edges of one of the types
+func (b *EntityRelationshipsSearchBuilder) EdgeTypes(types ...string) *EntityRelationshipsSearchBuilder {
+	b.request.EdgeTypes = &types
+	return b
+}
+
+// Direction keeps only edges entering or leaving the center node
+func (b *EntityRelationshipsSearchBuilder) Direction(direction EdgeDirection) *EntityRelationshipsSearchBuilder {
+	b.request.Direction = direction
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *EntityRelationshipsSearchBuilder) Max(n int) *EntityRelationshipsSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *EntityRelationshipsSearchBuilder) QueryExpansion(enabled bool) *EntityRelationshipsSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *EntityRelationshipsSearchBuilder) Observe(observation *Observation) *EntityRelationshipsSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *EntityRelationshipsSearchBuilder) Request() EntityRelationshipSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *EntityRelationshipsSearchBuilder) Do(ctx context.Context, client *Client) (*EntityRelationshipSearchResponse, error) {
+	return client.entityRelationshipsSearch(ctx, b.request)
+}
+
+// DiverseSearchBuilder builds a DiverseSearchRequest
+type DiverseSearchBuilder struct {
+	request DiverseSearchRequest
+}
+
+// NewDiverseSearch starts a diverse results search for query
+func NewDiverseSearch(query string) *DiverseSearchBuilder {
+	return &DiverseSearchBuilder{request: DiverseSearchRequest{Query: query}}
+}
+
+// Group restricts the search to a group
+func (b *DiverseSearchBuilder) Group(groupID string) *DiverseSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *DiverseSearchBuilder) Groups(groupIDs ...string) *DiverseSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// Diversity sets how strongly redundant results are penalized
+func (b *DiverseSearchBuilder) Diversity(level DiversityLevel) *DiverseSearchBuilder {
+	b.request.DiversityLevel = level
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *DiverseSearchBuilder) Max(n int) *DiverseSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *DiverseSearchBuilder) QueryExpansion(enabled bool) *DiverseSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *DiverseSearchBuilder) Observe(observation *Observation) *DiverseSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *DiverseSearchBuilder) Request() DiverseSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *DiverseSearchBuilder) Do(ctx context.Context, client *Client) (*DiverseSearchResponse, error) {
+	return client.diverseResultsSearch(ctx, b.request)
+}
+
+// EpisodeContextSearchBuilder builds an EpisodeContextSearchRequest
+type EpisodeContextSearchBuilder struct {
+	request EpisodeContextSearchRequest
+}
+
+// NewEpisodeContextSearch starts an episode context search for query
+func NewEpisodeContextSearch(query string) *EpisodeContextSearchBuilder {
+	return &EpisodeContextSearchBuilder{request: EpisodeContextSearchRequest{Query: query}}
+}
+
+// Group restricts the search to a group
+func (b *EpisodeContextSearchBuilder) Group(groupID string) *EpisodeContextSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *EpisodeContextSearchBuilder) Groups(groupIDs ...string) *EpisodeContextSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *EpisodeContextSearchBuilder) Max(n int) *EpisodeContextSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *EpisodeContextSearchBuilder) QueryExpansion(enabled bool) *EpisodeContextSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *EpisodeContextSearchBuilder) Observe(observation *Observation) *EpisodeContextSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *EpisodeContextSearchBuilder) Request() EpisodeContextSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *EpisodeContextSearchBuilder) Do(ctx context.Context, client *Client) (*EpisodeContextSearchResponse, error) {
+	return client.episodeContextSearch(ctx, b.request)
+}
+
+// SuccessfulToolsSearchBuilder builds a SuccessfulToolsSearchRequest
+type SuccessfulToolsSearchBuilder struct {
+	request SuccessfulToolsSearchRequest
+}
+
+// NewSuccessfulToolsSearch starts a successful tools search for query
+func NewSuccessfulToolsSearch(query string) *SuccessfulToolsSearchBuilder {
+	return &SuccessfulToolsSearchBuilder{request: SuccessfulToolsSearchRequest{Query: query}}
+}
+
+// Group restricts the search to a group
+func (b *SuccessfulToolsSearchBuilder) Group(groupID string) *SuccessfulToolsSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *SuccessfulToolsSearchBuilder) Groups(groupIDs ...string) *SuccessfulToolsSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// MinMentions keeps only tools mentioned at least n times
+func (b *SuccessfulToolsSearchBuilder) MinMentions(n int) *SuccessfulToolsSearchBuilder {
+	b.request.MinMentions = n
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *SuccessfulToolsSearchBuilder) Max(n int) *SuccessfulToolsSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *SuccessfulToolsSearchBuilder) QueryExpansion(enabled bool) *SuccessfulToolsSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *SuccessfulToolsSearchBuilder) Observe(observation *Observation) *SuccessfulToolsSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *SuccessfulToolsSearchBuilder) Request() SuccessfulToolsSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *SuccessfulToolsSearchBuilder) Do(ctx context.Context, client *Client) (*SuccessfulToolsSearchResponse, error) {
+	return client.successfulToolsSearch(ctx, b.request)
+}
+
+// RecentContextSearchBuilder builds a RecentContextSearchRequest
+type RecentContextSearchBuilder struct {
+	request RecentContextSearchRequest
+}
+
+// NewRecentContextSearch starts a recent context search for query
+func NewRecentContextSearch(query string) *RecentContextSearchBuilder {
+	return &RecentContextSearchBuilder{request: RecentContextSearchRequest{Query: query}}
+}
+
+// Group restricts the search to a group
+func (b *RecentContextSearchBuilder) Group(groupID string) *RecentContextSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *RecentContextSearchBuilder) Groups(groupIDs ...string) *RecentContextSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// Within sets how far back the recency window reaches
+func (b *RecentContextSearchBuilder) Within(d time.Duration) *RecentContextSearchBuilder {
+	b.request.RecencyDuration = d
+	return b
+}
+
+// At makes the recency window end at t instead of the server's current time
+func (b *RecentContextSearchBuilder) At(t time.Time) *RecentContextSearchBuilder {
+	b.request.ReferenceTime = &t
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *RecentContextSearchBuilder) Max(n int) *RecentContextSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *RecentContextSearchBuilder) QueryExpansion(enabled bool) *RecentContextSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *RecentContextSearchBuilder) Observe(observation *Observation) *RecentContextSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *RecentContextSearchBuilder) Request() RecentContextSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *RecentContextSearchBuilder) Do(ctx context.Context, client *Client) (*RecentContextSearchResponse, error) {
+	return client.recentContextSearch(ctx, b.request)
+}
+
+// EntityByLabelSearchBuilder builds an EntityByLabelSearchRequest
+type EntityByLabelSearchBuilder struct {
+	request EntityByLabelSearchRequest
+}
+
+// NewEntityByLabelSearch starts a search for query among entities with one of the labels
+func NewEntityByLabelSearch(query string, labels ...string) *EntityByLabelSearchBuilder {
+	return &EntityByLabelSearchBuilder{request: EntityByLabelSearchRequest{Query: query, NodeLabels: labels}}
+}
+
+// Group restricts the search to a group
+func (b *EntityByLabelSearchBuilder) Group(groupID string) *EntityByLabelSearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *EntityByLabelSearchBuilder) Groups(groupIDs ...string) *EntityByLabelSearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// EdgeTypes keeps only edges of one of the types
+func (b *EntityByLabelSearchBuilder) EdgeTypes(types ...string) *EntityByLabelSearchBuilder {
+	b.request.EdgeTypes = &types
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *EntityByLabelSearchBuilder) Max(n int) *EntityByLabelSearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *EntityByLabelSearchBuilder) QueryExpansion(enabled bool) *EntityByLabelSearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *EntityByLabelSearchBuilder) Observe(observation *Observation) *EntityByLabelSearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *EntityByLabelSearchBuilder) Request() EntityByLabelSearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *EntityByLabelSearchBuilder) Do(ctx context.Context, client *Client) (*EntityByLabelSearchResponse, error) {
+	return client.entityByLabelSearch(ctx, b.request)
+}
+
+// CommunitySearchBuilder builds a CommunitySearchRequest
+type CommunitySearchBuilder struct {
+	request CommunitySearchRequest
+}
+
+// NewCommunitySearch starts a community search for query
+func NewCommunitySearch(query string) *CommunitySearchBuilder {
+	return &CommunitySearchBuilder{request: CommunitySearchRequest{Query: query}}
+}
+
+// Group restricts the search to a group
+func (b *CommunitySearchBuilder) Group(groupID string) *CommunitySearchBuilder {
+	b.request.GroupID = &groupID
+	return b
+}
+
+// Groups restricts the search to several groups, taking precedence over Group
+func (b *CommunitySearchBuilder) Groups(groupIDs ...string) *CommunitySearchBuilder {
+	b.request.GroupIDs = &groupIDs
+	return b
+}
+
+// Max sets the maximum number of results
+func (b *CommunitySearchBuilder) Max(n int) *CommunitySearchBuilder {
+	b.request.MaxResults = n
+	return b
+}
+
+// QueryExpansion toggles server-side query expansion
+func (b *CommunitySearchBuilder) QueryExpansion(enabled bool) *CommunitySearchBuilder {
+	b.request.EnableQueryExpansion = &enabled
+	return b
+}
+
+// Observe attaches a Langfuse observation
+func (b *CommunitySearchBuilder) Observe(observation *Observation) *CommunitySearchBuilder {
+	b.request.Observation = observation
+	return b
+}
+
+// Request returns the assembled request
+func (b *CommunitySearchBuilder) Request() CommunitySearchRequest {
+	return b.request
+}
+
+// Do runs the search with client
+func (b *CommunitySearchBuilder) Do(ctx context.Context, client *Client) (*CommunitySearchResponse, error) {
+	return client.communitySearch(ctx, b.request)
+}
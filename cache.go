@@ -0,0 +1,130 @@
+package graphiti
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores raw response bodies of idempotent reads. Implementations must
+// be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key if it has not expired
+	Get(key string) ([]byte, bool)
+	// Set stores value for key for the given time to live
+	Set(key string, value []byte, ttl time.Duration)
+	// Purge removes all entries
+	Purge()
+}
+
+// WithCache caches the responses of GET requests for the health check,
+// episodes, entity nodes and entity edges in memory for ttl. Any other
+// request that is not a read (adding messages or nodes, deletes, clearing the
+// graph) purges the cache. Polling helpers such as WaitForEpisodes bypass it.
+func WithCache(ttl time.Duration) ClientOption {
+	return WithCacheStore(newMemoryCache(), ttl)
+}
+
+// WithCacheStore is like WithCache but stores responses in the given cache,
+// e.g. one shared between clients or backed by an external store
+func WithCacheStore(cache Cache, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// cachedPrefixes lists the GET endpoints whose responses are cached
+var cachedPrefixes = []string{"/healthcheck", "/episodes/", "/entity-edge/", "/entity-node/"}
+
+// cacheKey returns the cache key of a request, or "" if it is not cacheable
+func (c *Client) cacheKey(ctx context.Context, method, path string) string {
+	if c.cache == nil || c.dryRun || method != http.MethodGet || cacheBypassed(ctx) {
+		return ""
+	}
+	for _, prefix := range cachedPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			// responses may differ per end user, so the subject is part of the key
+			return method + " " + path + "\n" + c.onBehalfOf
+		}
+	}
+	return ""
+}
+
+// invalidateCache purges the cache after a request that may modify data:
+// every request except GET, HEAD and the read-only search and memory queries.
+// Idempotent writes such as DELETE and PUT still change data, so they purge too.
+func (c *Client) invalidateCache(method, path string) {
+	if c.cache == nil || method == http.MethodGet || method == http.MethodHead {
+		return
+	}
+	if method == http.MethodPost && (strings.HasPrefix(path, "/search") || path == "/get-memory") {
+		return
+	}
+	c.cache.Purge()
+}
+
+// cacheBypassKey is the context key that disables the response cache
+type cacheBypassKey struct{}
+
+// withoutCache returns a context whose requests skip the response cache
+func withoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// memoryCache is the in-memory Cache used by WithCache
+type memoryCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: make(map[string]cacheEntry)}
+}
+
+func (m *memoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (m *memoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// drop expired entries so the cache does not grow without bound
+	now := time.Now()
+	for k, entry := range m.entries {
+		if now.After(entry.expires) {
+			delete(m.entries, k)
+		}
+	}
+	m.entries[key] = cacheEntry{value: value, expires: now.Add(ttl)}
+}
+
+func (m *memoryCache) Purge() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[string]cacheEntry)
+}
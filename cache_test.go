@@ -0,0 +1,74 @@
+package graphiti_test
+
+import (
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestCacheIsPurgedByDeletes(t *testing.T) {
+	tests := []struct {
+		name   string
+		delete func(client *graphiti.Client) error
+	}{
+		{"DeleteEpisode", func(client *graphiti.Client) error {
+			_, err := client.DeleteEpisode("e1")
+			return err
+		}},
+		{"DeleteGroup", func(client *graphiti.Client) error {
+			_, err := client.DeleteGroup("g")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := graphititest.NewMockServer()
+			defer server.Close()
+			server.AddEpisodes(graphititest.NewEpisode("e1", "g", "hello", time.Now().UTC()))
+
+			client := server.Client(graphiti.WithCache(time.Hour))
+			if episodes, err := client.GetEpisodes("g", 10); err != nil || len(episodes) != 1 {
+				t.Fatalf("GetEpisodes = %v, %v, want the seeded episode", episodes, err)
+			}
+
+			if err := tt.delete(client); err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+
+			episodes, err := client.GetEpisodes("g", 10)
+			if err != nil {
+				t.Fatalf("GetEpisodes after delete: %v", err)
+			}
+			if len(episodes) != 0 {
+				t.Errorf("GetEpisodes after delete returned cached episodes: %+v", episodes)
+			}
+		})
+	}
+}
+
+func TestCacheIsKeptBySearch(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	server.AddEpisodes(graphititest.NewEpisode("e1", "g", "hello", time.Now().UTC()))
+
+	client := server.Client(graphiti.WithCache(time.Hour))
+	if _, err := client.GetEpisodes("g", 10); err != nil {
+		t.Fatalf("GetEpisodes: %v", err)
+	}
+	if _, err := client.Search(graphiti.SearchQuery{Query: "hello", MaxFacts: 5}); err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	// a change behind the client's back is only visible if the cache was purged
+	server.AddEpisodes(graphititest.NewEpisode("e2", "g", "world", time.Now().UTC()))
+	episodes, err := client.GetEpisodes("g", 10)
+	if err != nil {
+		t.Fatalf("GetEpisodes: %v", err)
+	}
+	if len(episodes) != 1 {
+		t.Errorf("Search purged the cache: got %d episodes, want the cached 1", len(episodes))
+	}
+}
@@ -0,0 +1,103 @@
+package graphiti
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// Features reported in ServerInfo.Features
+const (
+	FeatureAdvancedSearch = "advanced_search"
+	FeatureCommunities    = "communities"
+	FeatureReindex        = "reindex"
+	FeatureJobs           = "jobs"
+	FeatureMergeNodes     = "merge_nodes"
+)
+
+// ServerInfo describes the server's version and the optional features it supports.
+// Features is nil when the server does not report them.
+type ServerInfo struct {
+	Version  string   `json:"version"`
+	Features []string `json:"features"`
+}
+
+// Supports reports whether the server supports a feature. It returns true
+// when the server does not report its features, so that servers predating
+// capability discovery are not rejected.
+func (i *ServerInfo) Supports(feature string) bool {
+	return i.Features == nil || slices.Contains(i.Features, feature)
+}
+
+// ServerInfo retrieves the server's version and features from its info
+// endpoint. Servers without one are described by their health check, which
+// may carry a version but no features.
+func (c *Client) ServerInfo() (*ServerInfo, error) {
+	return c.serverInfo(context.Background())
+}
+
+func (c *Client) serverInfo(ctx context.Context) (*ServerInfo, error) {
+	var result ServerInfo
+	err := c.doContext(ctx, http.MethodGet, "/info", nil, &result)
+	if !isMissing(err) {
+		if err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+
+	var health HealthCheckResponse
+	if err := c.doContext(ctx, http.MethodGet, "/healthcheck", nil, &health); err != nil {
+		return nil, err
+	}
+	return &ServerInfo{Version: health.Version}, nil
+}
+
+// WithCapabilityCheck makes methods that need an optional feature (the
+// advanced and community searches, reindexing, job inspection and node
+// merging) fail with ErrUnsupported, without sending the request, when the
+// server does not report the feature. The server's features are discovered on the first
+// such call and cached for the lifetime of the client.
+func WithCapabilityCheck() ClientOption {
+	return func(c *Client) {
+		c.capabilities = &capabilityCache{}
+	}
+}
+
+// capabilityCache holds the server info discovered for capability checks.
+// Failed discoveries are not cached, so they are retried on the next call.
+type capabilityCache struct {
+	mu   sync.Mutex
+	info *ServerInfo
+}
+
+// requireFeature returns ErrUnsupported if capability checks are enabled and
+// the server does not support the feature
+func (c *Client) requireFeature(ctx context.Context, feature string) error {
+	if c.capabilities == nil {
+		return nil
+	}
+
+	c.capabilities.mu.Lock()
+	defer c.capabilities.mu.Unlock()
+
+	if c.capabilities.info == nil {
+		info, err := c.serverInfo(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to discover server capabilities: %w", err)
+		}
+		c.capabilities.info = info
+	}
+
+	info := c.capabilities.info
+	if !info.Supports(feature) {
+		version := info.Version
+		if version == "" {
+			version = "unknown"
+		}
+		return fmt.Errorf("feature %s on server version %s: %w", feature, version, ErrUnsupported)
+	}
+	return nil
+}
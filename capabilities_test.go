@@ -0,0 +1,68 @@
+package graphiti_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestCapabilityCheckRejectsMissingFeatures(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	server.Respond(graphititest.RouteServerInfo, http.StatusOK, graphiti.ServerInfo{
+		Version:  "test",
+		Features: []string{graphiti.FeatureAdvancedSearch},
+	})
+	client := server.Client(graphiti.WithCapabilityCheck())
+
+	calls := map[string]func() error{
+		"ReindexGroup": func() error {
+			_, err := client.ReindexGroup("g")
+			return err
+		},
+		"GetReindexStatus": func() error {
+			_, err := client.GetReindexStatus("g")
+			return err
+		},
+		"GetPendingJobs": func() error {
+			_, err := client.GetPendingJobs("g")
+			return err
+		},
+		"MergeEntityNodes": func() error {
+			_, err := client.MergeEntityNodes(graphiti.MergeNodesRequest{
+				PrimaryUUID:    "a",
+				DuplicateUUIDs: []string{"b"},
+			})
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, graphiti.ErrUnsupported) {
+			t.Errorf("%s: error = %v, want ErrUnsupported", name, err)
+		}
+	}
+}
+
+func TestCapabilityCheckAllowsReportedFeatures(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	primary := graphititest.NewEntityNode("a", "g", "Alice")
+	duplicate := graphititest.NewEntityNode("b", "g", "Alice Smith")
+	server.AddEntityNodes(primary, duplicate)
+
+	node, err := server.Client(graphiti.WithCapabilityCheck()).MergeEntityNodes(graphiti.MergeNodesRequest{
+		PrimaryUUID:    primary.UUID,
+		DuplicateUUIDs: []string{duplicate.UUID},
+	})
+	if err != nil {
+		t.Fatalf("MergeEntityNodes: %v", err)
+	}
+	if node.UUID != primary.UUID {
+		t.Errorf("merged node = %q, want %q", node.UUID, primary.UUID)
+	}
+}
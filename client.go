@@ -2,18 +2,68 @@ package graphiti
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// edgesBetweenMaxResults limits the relationship search used by GetEdgesBetween
+	edgesBetweenMaxResults = 100
+	// deleteGroupsParallelism limits the concurrent requests of DeleteGroups
+	deleteGroupsParallelism = 8
+	// deleteEpisodesParallelism limits the concurrent requests of DeleteEpisodesBefore
+	deleteEpisodesParallelism = 8
+	// getEntityEdgesParallelism limits the concurrent requests of GetEntityEdges
+	getEntityEdgesParallelism = 8
+	// searchAllMaxFacts caps the number of facts SearchAll requests
+	searchAllMaxFacts = 10000
 )
 
 // Client represents a Graphiti API client
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL         string
+	httpClient      *http.Client
+	maxMessageBytes int
+	trailingSlash   bool
+	onBehalfOf      string
+	signer          *requestSigner
+	clientInfo      string
+	health          *healthProbe
+	replaceLabels   bool
+	requireGroup    bool
+	retry           *retryPolicy
+	headers         http.Header
+	userAgent       string
+	compression     bool
+	destructive     bool
+	limiter         *rate.Limiter
+	requestTimeout  time.Duration
+	metrics         MetricsObserver
+	configErr       error
+	cache           Cache
+	cacheTTL        time.Duration
+	defaultGroupID  string
+	customClient    bool
+	ownTransport    bool
+	pool            connPool
+	lastResponse    *headerRecorder
+	capabilities    *capabilityCache
+	dryRun          bool
+	breaker         *circuitBreaker
+	strictDecoding  bool
+	timeFormat      timeFormat
+	trace           func(TraceInfo)
 }
 
 // ClientOption is a functional option for configuring the Client
@@ -23,6 +73,8 @@ type ClientOption func(*Client)
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Client) {
 		c.httpClient = httpClient
+		c.customClient = true
+		c.ownTransport = false
 	}
 }
 
@@ -33,61 +85,359 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
-// NewClient creates a new Graphiti API client
+// WithHeader adds a header sent with every request
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Add(key, value)
+	}
+}
+
+// WithAPIKey authenticates every request with an "Authorization: Bearer <token>" header
+func WithAPIKey(token string) ClientOption {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// WithMaxMessageBytes sets the maximum message content size in bytes.
+// Larger messages are split into linked parts before AddMessages.
+func WithMaxMessageBytes(n int) ClientOption {
+	return func(c *Client) {
+		c.maxMessageBytes = n
+	}
+}
+
+// WithReplaceLabels makes AddEntityNode replace the labels and metadata of an
+// existing node instead of merging them
+func WithReplaceLabels() ClientOption {
+	return func(c *Client) {
+		c.replaceLabels = true
+	}
+}
+
+// WithRequireExistingGroup makes AddMessages fail with ErrGroupNotFound instead
+// of creating a new group when the group does not exist yet
+func WithRequireExistingGroup() ClientOption {
+	return func(c *Client) {
+		c.requireGroup = true
+	}
+}
+
+// WithTrailingSlash appends a trailing slash to all endpoint paths,
+// for reverse proxies that redirect non-slash paths
+func WithTrailingSlash() ClientOption {
+	return func(c *Client) {
+		c.trailingSlash = true
+	}
+}
+
+// WithDestructiveOperationsEnabled allows Clear to wipe the graph.
+// Without it Clear fails with ErrDestructiveDisabled.
+func WithDestructiveOperationsEnabled() ClientOption {
+	return func(c *Client) {
+		c.destructive = true
+	}
+}
+
+// NewClient creates a new Graphiti API client. The base URL may include a
+// path prefix (e.g. "https://host/api/graphiti") for reverse-proxied
+// deployments; trailing slashes are ignored. If the base URL is not valid
+// according to ValidateBaseURL, every request fails with the validation error;
+// use NewClientWithConfig or ValidateBaseURL to detect this up front.
 func NewClient(baseURL string, opts ...ClientOption) *Client {
 	client := &Client{
-		baseURL: baseURL,
+		baseURL: strings.TrimRight(baseURL, "/"),
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		clientInfo:   BuildInfo.String(),
+		userAgent:    DefaultUserAgent,
+		lastResponse: &headerRecorder{},
 	}
 
+	if err := ValidateBaseURL(baseURL); err != nil {
+		client.setConfigErr(err)
+	}
 	for _, opt := range opts {
 		opt(client)
 	}
+	client.applyConnPool()
+
+	if client.health != nil {
+		go client.health.run(client)
+	}
 
 	return client
 }
 
+// OnBehalfOf returns a copy of the client that attributes all its requests
+// to the given end-user subject via the X-On-Behalf-Of header. The copy shares
+// the underlying HTTP client, so it is cheap to create per request.
+func (c *Client) OnBehalfOf(subject string) *Client {
+	clone := *c
+	clone.onBehalfOf = subject
+	return &clone
+}
+
+// WithRequestTimeout returns a copy of the client whose calls each time out
+// after d, including retries, independently of the HTTP client timeout set
+// with WithTimeout. It composes with any deadline of a caller-supplied
+// context: the shorter one wins. The copy shares the underlying HTTP client.
+func (c *Client) WithRequestTimeout(d time.Duration) *Client {
+	clone := *c
+	clone.requestTimeout = d
+	return &clone
+}
+
+// requestContext bounds ctx by the per-request timeout, if any
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.requestTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.requestTimeout)
+}
+
 // do performs an HTTP request and decodes the response
 func (c *Client) do(method, path string, body interface{}, result interface{}) error {
-	var reqBody io.Reader
-	if body != nil {
-		jsonData, err := json.Marshal(body)
+	return c.doContext(context.Background(), method, path, body, result)
+}
+
+// doContext performs an HTTP request bound to ctx and decodes the response
+func (c *Client) doContext(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	if key := c.cacheKey(ctx, method, path); key != "" && result != nil {
+		return c.doCached(ctx, key, method, path, result)
+	}
+
+	raw, err := c.sendBuffered(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	if result != nil {
+		return c.decodeResponse(raw, result)
+	}
+	return nil
+}
+
+// doCached serves a body-less request from the cache, storing the response on a miss
+func (c *Client) doCached(ctx context.Context, key, method, path string, result interface{}) error {
+	raw, ok := c.cache.Get(key)
+	if !ok {
+		var err error
+		if raw, err = c.sendBuffered(ctx, method, path, nil); err != nil {
+			return err
+		}
+		c.cache.Set(key, raw, c.cacheTTL)
+	}
+	return c.decodeResponse(raw, result)
+}
+
+// doRaw performs an HTTP request, decodes the response and returns the raw body
+func (c *Client) doRaw(method, path string, body interface{}, result interface{}) (json.RawMessage, error) {
+	ctx, cancel := c.requestContext(context.Background())
+	defer cancel()
+
+	raw, err := c.sendBuffered(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode from the same buffer that is returned to avoid a second copy
+	if err := c.decodeResponse(raw, result); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// send performs an HTTP request and returns the response if it has a 2xx status,
+// retrying transient failures according to the retry policy.
+// The caller is responsible for closing the response body.
+func (c *Client) send(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	if c.configErr != nil {
+		return nil, fmt.Errorf("invalid client configuration: %w", c.configErr)
+	}
+
+	jsonData, err := c.encodeBody(body)
+	if err != nil {
+		return nil, err
+	}
+	if c.dryRun {
+		preview, err := c.preview(ctx, method, path, jsonData, body != nil)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, err
+		}
+		return nil, &DryRunError{Request: preview}
+	}
+
+	if c.breaker != nil {
+		if err := c.breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	// purge the cache even if the request fails, as a write may have been applied
+	defer c.invalidateCache(method, path)
+
+	resp, err := c.sendWithRetry(ctx, method, path, jsonData, body != nil)
+	if c.breaker != nil {
+		c.breaker.record(ctx, err)
+	}
+	return resp, err
+}
+
+// sendWithRetry sends the encoded request, retrying transient failures
+func (c *Client) sendWithRetry(ctx context.Context, method, path string, jsonData []byte, hasBody bool) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, fmt.Errorf("rate limit wait failed: %w", err)
+			}
+		}
+
+		start := time.Now()
+		attemptCtx, trace := c.startTrace(ctx)
+		resp, err := c.sendOnce(attemptCtx, method, path, jsonData, hasBody)
+		c.observe(method, path, resp, start, err)
+		trace.finish(method, path, resp, err)
+		if err == nil || !c.retry.shouldRetry(attempt, method, path, err) {
+			return resp, err
+		}
+
+		if err := sleepContext(ctx, c.retry.delay(attempt, err)); err != nil {
+			return nil, fmt.Errorf("retry backoff interrupted: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonData)
 	}
+}
 
-	reqURL := c.baseURL + path
-	req, err := http.NewRequest(method, reqURL, reqBody)
+// encodeBody marshals the request body to JSON, compressing it if enabled
+func (c *Client) encodeBody(body interface{}) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	jsonData, err := json.Marshal(c.timeFormat.apply(body))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	if c.compression {
+		if jsonData, err = gzipBytes(jsonData); err != nil {
+			return nil, fmt.Errorf("failed to compress request body: %w", err)
+		}
 	}
+	return jsonData, nil
+}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+// sendOnce performs a single HTTP request attempt
+func (c *Client) sendOnce(ctx context.Context, method, path string, jsonData []byte, hasBody bool) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, path, jsonData, hasBody)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to perform request: %w", err)
+		return nil, fmt.Errorf("failed to perform request: %w", err)
+	}
+	c.lastResponse.record(resp)
+	if c.compression {
+		if err := decompressBody(resp); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("failed to decompress response: %w", err)
+		}
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp, bodyBytes)
 	}
 
-	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
+	if bodyBuffered(ctx) {
+		if _, err := bufferBody(resp); err != nil {
+			return nil, err
 		}
 	}
+	return resp, nil
+}
 
-	return nil
+// newRequest builds an HTTP request with the client's headers and signature
+func (c *Client) newRequest(ctx context.Context, method, path string, jsonData []byte, hasBody bool) (*http.Request, error) {
+	var reqBody io.Reader
+	if hasBody {
+		// bytes.Reader lets http.NewRequest set GetBody, so the body is
+		// replayed when following 307/308 redirects
+		reqBody = bytes.NewReader(jsonData)
+	}
+
+	reqURL := c.baseURL + c.endpoint(path)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	for key, values := range requestHeaders(ctx) {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if hasBody {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.compression {
+		// setting Accept-Encoding disables the transport's transparent
+		// decompression, so responses are decoded by decompressBody
+		req.Header.Set("Accept-Encoding", "gzip")
+		if hasBody {
+			req.Header.Set("Content-Encoding", "gzip")
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if c.clientInfo != "" {
+		req.Header.Set("X-Client-Info", c.clientInfo)
+	}
+	if c.onBehalfOf != "" {
+		req.Header.Set("X-On-Behalf-Of", c.onBehalfOf)
+	}
+	if c.signer != nil {
+		c.signer.sign(req, jsonData)
+	}
+
+	return req, nil
+}
+
+// endpoint returns the request path relative to the base URL, ensuring a
+// leading slash and applying the trailing slash setting
+func (c *Client) endpoint(path string) string {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if !c.trailingSlash {
+		return path
+	}
+
+	query := ""
+	if idx := strings.IndexByte(path, '?'); idx >= 0 {
+		path, query = path[:idx], path[idx:]
+	}
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+
+	return path + query
 }
 
 // HealthCheck performs a health check on the API
@@ -101,13 +451,86 @@ func (c *Client) HealthCheck() (*HealthCheckResponse, error) {
 
 // Search searches for facts in the graph
 func (c *Client) Search(query SearchQuery) (*SearchResults, error) {
+	query.GroupIDs = c.groupIDs(query.GroupIDs)
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	filter, err := query.postFilter()
+	if err != nil {
+		return nil, err
+	}
+
 	var result SearchResults
 	if err := c.do(http.MethodPost, "/search", query, &result); err != nil {
 		return nil, err
 	}
+	if filter != nil {
+		result.Facts = filterFacts(result.Facts, filter)
+	}
 	return &result, nil
 }
 
+// SearchWithRaw searches for facts in the graph and also returns the raw JSON response.
+// PostFilterRegex is not applied, so the typed result matches the raw response.
+func (c *Client) SearchWithRaw(query SearchQuery) (*SearchResults, json.RawMessage, error) {
+	query.GroupIDs = c.groupIDs(query.GroupIDs)
+	if err := query.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var result SearchResults
+	raw, err := c.doRaw(http.MethodPost, "/search", query, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &result, raw, nil
+}
+
+// SearchAll returns every fact matching the query. The server cannot page
+// search results, so SearchAll repeats the search with max_facts starting at
+// pageSize and doubling until the server returns fewer facts than requested.
+// The query's MaxFacts is ignored. If more than 10000 facts match, it returns
+// the first 10000 together with an error wrapping ErrResultsTruncated.
+func (c *Client) SearchAll(ctx context.Context, query SearchQuery, pageSize int) ([]FactResult, error) {
+	query.GroupIDs = c.groupIDs(query.GroupIDs)
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+	if pageSize <= 0 {
+		return nil, invalidf("page size must be positive")
+	}
+
+	filter, err := query.postFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	var facts []FactResult
+	for size := min(pageSize, searchAllMaxFacts); ; size = min(size*2, searchAllMaxFacts) {
+		query.MaxFacts = size
+
+		var result SearchResults
+		if err := c.doContext(ctx, http.MethodPost, "/search", query, &result); err != nil {
+			return nil, err
+		}
+		facts = result.Facts
+
+		if len(facts) < size {
+			break
+		}
+		if size == searchAllMaxFacts {
+			err = fmt.Errorf("search matched more than %d facts: %w", searchAllMaxFacts, ErrResultsTruncated)
+			break
+		}
+	}
+
+	if filter != nil {
+		facts = filterFacts(facts, filter)
+	}
+	return facts, err
+}
+
 // GetEntityEdge retrieves a specific entity edge by UUID
 func (c *Client) GetEntityEdge(uuid string) (*FactResult, error) {
 	var result FactResult
@@ -118,6 +541,50 @@ func (c *Client) GetEntityEdge(uuid string) (*FactResult, error) {
 	return &result, nil
 }
 
+// GetEntityEdges retrieves entity edges by UUID, fetching at most
+// getEntityEdgesParallelism concurrently. The edges are returned in the order
+// of uuids. If some edges cannot be retrieved, the others are still returned
+// together with a *PartialError holding the error of each failed UUID.
+func (c *Client) GetEntityEdges(uuids []string) ([]FactResult, error) {
+	var (
+		wg      sync.WaitGroup
+		results = make([]*FactResult, len(uuids))
+		errs    = make([]error, len(uuids))
+		sem     = make(chan struct{}, getEntityEdgesParallelism)
+	)
+
+	for i, uuid := range uuids {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, uuid string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = c.GetEntityEdge(uuid)
+		}(i, uuid)
+	}
+	wg.Wait()
+
+	edges := make([]FactResult, 0, len(uuids))
+	failures := make(map[string]error)
+	for i, result := range results {
+		if errs[i] != nil {
+			failures[uuids[i]] = errs[i]
+			continue
+		}
+		edges = append(edges, *result)
+	}
+
+	if len(failures) > 0 {
+		return edges, &PartialError{Failures: failures}
+	}
+	return edges, nil
+}
+
+// EntityEdgeExists reports whether an entity edge exists without downloading it
+func (c *Client) EntityEdgeExists(uuid string) (bool, error) {
+	return c.exists(fmt.Sprintf("/entity-edge/%s", url.PathEscape(uuid)))
+}
+
 // GetEpisodes retrieves episodes for a group
 func (c *Client) GetEpisodes(groupID string, lastN int) ([]Episode, error) {
 	var result []Episode
@@ -128,26 +595,221 @@ func (c *Client) GetEpisodes(groupID string, lastN int) ([]Episode, error) {
 	return result, nil
 }
 
+// GetEpisodesInRange retrieves up to lastN episodes for a group that fall
+// between since and until. A zero since or until leaves that side unbounded.
+func (c *Client) GetEpisodesInRange(groupID string, lastN int, since, until time.Time) ([]Episode, error) {
+	query := url.Values{}
+	query.Set("last_n", strconv.Itoa(lastN))
+	if !since.IsZero() {
+		query.Set("since", since.UTC().Format(time.RFC3339Nano))
+	}
+	if !until.IsZero() {
+		query.Set("until", until.UTC().Format(time.RFC3339Nano))
+	}
+
+	var result []Episode
+	path := fmt.Sprintf("/episodes/%s?%s", url.PathEscape(groupID), query.Encode())
+	if err := c.do(http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetEpisodesFiltered retrieves up to filter.LastN episodes for a group that
+// were written by filter.Author and are named filter.Name. The filters are
+// sent as the author and name query parameters and applied again client-side,
+// so servers that ignore them still return only matching episodes, though
+// possibly fewer than LastN since the server limits before filtering. The
+// author is matched against the "author: " prefix the server gives message
+// episode content.
+func (c *Client) GetEpisodesFiltered(groupID string, filter EpisodeFilter) ([]Episode, error) {
+	query := url.Values{}
+	query.Set("last_n", strconv.Itoa(filter.LastN))
+	if filter.Author != "" {
+		query.Set("author", filter.Author)
+	}
+	if filter.Name != "" {
+		query.Set("name", filter.Name)
+	}
+
+	var episodes []Episode
+	path := fmt.Sprintf("/episodes/%s?%s", url.PathEscape(groupID), query.Encode())
+	if err := c.do(http.MethodGet, path, nil, &episodes); err != nil {
+		return nil, err
+	}
+
+	result := make([]Episode, 0, len(episodes))
+	for _, episode := range episodes {
+		if filter.matches(episode) {
+			result = append(result, episode)
+		}
+	}
+	return result, nil
+}
+
+// GetEpisodesPage retrieves a page of episodes for a group. Pass the previous
+// page's NextCursor in opts.Cursor to fetch the following page.
+func (c *Client) GetEpisodesPage(groupID string, opts EpisodePageOptions) (*EpisodePage, error) {
+	return c.getEpisodesPage(context.Background(), groupID, opts)
+}
+
+func (c *Client) getEpisodesPage(ctx context.Context, groupID string, opts EpisodePageOptions) (*EpisodePage, error) {
+	var result EpisodePage
+	path := fmt.Sprintf("/episodes/%s/page", url.PathEscape(groupID))
+	if query := opts.values().Encode(); query != "" {
+		path += "?" + query
+	}
+	if err := c.doContext(ctx, http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // GetMemory retrieves memory based on messages
 func (c *Client) GetMemory(request GetMemoryRequest) (*GetMemoryResponse, error) {
+	return c.getMemory(context.Background(), request)
+}
+
+func (c *Client) getMemory(ctx context.Context, request GetMemoryRequest) (*GetMemoryResponse, error) {
+	request.GroupID = c.groupID(request.GroupID)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
 	var result GetMemoryResponse
-	if err := c.do(http.MethodPost, "/get-memory", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/get-memory", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
 // AddMessages adds messages to the graph (asynchronous operation)
-func (c *Client) AddMessages(request AddMessagesRequest) (*Result, error) {
-	var result Result
-	if err := c.do(http.MethodPost, "/messages", request, &result); err != nil {
+func (c *Client) AddMessages(request AddMessagesRequest) (*AddMessagesResponse, error) {
+	return c.addMessages(context.Background(), request)
+}
+
+func (c *Client) addMessages(ctx context.Context, request AddMessagesRequest) (*AddMessagesResponse, error) {
+	request.GroupID = c.groupID(request.GroupID)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	if c.requireGroup {
+		exists, err := c.groupExists(request.GroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check group %s: %w", request.GroupID, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("group %s: %w", request.GroupID, ErrGroupNotFound)
+		}
+	}
+	if c.maxMessageBytes > 0 {
+		request.Messages = splitMessages(request.Messages, c.maxMessageBytes)
+	}
+
+	if request.IdempotencyKey != "" {
+		ctx = withRequestHeader(ctx, idempotencyKeyHeader, request.IdempotencyKey)
+	}
+
+	var result AddMessagesResponse
+	if err := c.doContext(ctx, http.MethodPost, "/messages", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
-// AddEntityNode adds an entity node to the graph
+// AddMessagesBatched adds messages in sequential chunks of chunkSize, preserving
+// order. If a chunk fails, it returns the results of the chunks sent so far and
+// a *BatchError whose Index is the first message that was not added, so the
+// caller can resume from request.Messages[Index:]. When IdempotencyKey is set,
+// each chunk is sent with the key suffixed by the chunk's offset ("key-0",
+// "key-50", ...).
+func (c *Client) AddMessagesBatched(request AddMessagesRequest, chunkSize int) ([]*AddMessagesResponse, error) {
+	if chunkSize <= 0 {
+		return nil, invalidf("chunk size must be positive")
+	}
+
+	results := make([]*AddMessagesResponse, 0, (len(request.Messages)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(request.Messages); start += chunkSize {
+		end := min(start+chunkSize, len(request.Messages))
+
+		chunk := request
+		chunk.Messages = request.Messages[start:end]
+		if request.IdempotencyKey != "" {
+			chunk.IdempotencyKey = request.IdempotencyKey + "-" + strconv.Itoa(start)
+		}
+		result, err := c.AddMessages(chunk)
+		if err != nil {
+			return results, &BatchError{Index: start, Err: err}
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// groupExists reports whether the group exists. It issues a HEAD request for
+// the group and falls back to looking for any episode if HEAD is not allowed.
+func (c *Client) groupExists(groupID string) (bool, error) {
+	path := fmt.Sprintf("/group/%s", url.PathEscape(groupID))
+	err := c.do(http.MethodHead, path, nil, nil)
+
+	var apiErr *APIError
+	switch {
+	case err == nil:
+		return true, nil
+	case !errors.As(err, &apiErr):
+		return false, err
+	case apiErr.StatusCode == http.StatusNotFound:
+		return false, nil
+	case apiErr.StatusCode != http.StatusMethodNotAllowed:
+		return false, err
+	}
+
+	episodes, err := c.GetEpisodes(groupID, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(episodes) > 0, nil
+}
+
+// exists reports whether the resource at path exists using a HEAD request,
+// falling back to GET if the server does not allow HEAD. A 404 means false.
+func (c *Client) exists(path string) (bool, error) {
+	err := c.do(http.MethodHead, path, nil, nil)
+	if statusCode(err) == http.StatusMethodNotAllowed {
+		err = c.do(http.MethodGet, path, nil, nil)
+	}
+
+	switch {
+	case err == nil:
+		return true, nil
+	case IsNotFound(err):
+		return false, nil
+	}
+	return false, err
+}
+
+// AddEntityNode adds an entity node to the graph. If a node with the same UUID
+// already exists, the provided labels and metadata are merged with the existing
+// ones unless the client was created with WithReplaceLabels.
 func (c *Client) AddEntityNode(request AddEntityNodeRequest) (*EntityNode, error) {
+	request.GroupID = c.groupID(request.GroupID)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !c.replaceLabels {
+		existing, err := c.findEntityNode(request.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			request.Labels = mergeLabels(existing.Labels, request.Labels)
+			request.Metadata = mergeMetadata(existing.Metadata, request.Metadata)
+		}
+	}
+
 	var result EntityNode
 	if err := c.do(http.MethodPost, "/entity-node", request, &result); err != nil {
 		return nil, err
@@ -155,6 +817,97 @@ func (c *Client) AddEntityNode(request AddEntityNodeRequest) (*EntityNode, error
 	return &result, nil
 }
 
+// UpdateEntityNode updates the name, summary, labels or metadata of an existing
+// entity node. It returns an APIError matching IsNotFound if the node does not exist.
+func (c *Client) UpdateEntityNode(request UpdateEntityNodeRequest) (*EntityNode, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result EntityNode
+	path := fmt.Sprintf("/entity-node/%s", url.PathEscape(request.UUID))
+	if err := c.do(http.MethodPatch, path, request, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// MergeEntityNodes folds duplicate entity nodes into the primary node and
+// returns the merged node. It fails with ErrNodeNotFound if any node does not
+// exist and with ErrInvalidRequest if the nodes belong to different groups.
+// It returns ErrUnsupported if the server cannot merge nodes.
+func (c *Client) MergeEntityNodes(request MergeNodesRequest) (*EntityNode, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(context.Background(), FeatureMergeNodes); err != nil {
+		return nil, err
+	}
+
+	primary, err := c.findEntityNode(request.PrimaryUUID)
+	if err != nil {
+		return nil, err
+	}
+	if primary == nil {
+		return nil, fmt.Errorf("primary node %s: %w", request.PrimaryUUID, ErrNodeNotFound)
+	}
+	for _, uuid := range request.DuplicateUUIDs {
+		duplicate, err := c.findEntityNode(uuid)
+		if err != nil {
+			return nil, err
+		}
+		if duplicate == nil {
+			return nil, fmt.Errorf("duplicate node %s: %w", uuid, ErrNodeNotFound)
+		}
+		if duplicate.GroupID != primary.GroupID {
+			return nil, invalidf("node %s belongs to group %s, not to group %s of the primary node",
+				uuid, duplicate.GroupID, primary.GroupID)
+		}
+	}
+
+	var result EntityNode
+	if err := c.do(http.MethodPost, "/entity-node/merge", request, &result); err != nil {
+		if isMissing(err) {
+			return nil, fmt.Errorf("merge nodes into %s: %w", request.PrimaryUUID, ErrUnsupported)
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetEntityNode retrieves a specific entity node by UUID
+func (c *Client) GetEntityNode(uuid string) (*EntityNode, error) {
+	var result EntityNode
+	path := fmt.Sprintf("/entity-node/%s", url.PathEscape(uuid))
+	if err := c.do(http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// findEntityNode retrieves an entity node by UUID, returning nil if it does not exist
+func (c *Client) findEntityNode(uuid string) (*EntityNode, error) {
+	node, err := c.GetEntityNode(uuid)
+	if isMissing(err) {
+		return nil, nil
+	}
+	return node, err
+}
+
+// AddEntityEdge adds an entity edge (fact) between two existing nodes
+func (c *Client) AddEntityEdge(request AddEntityEdgeRequest) (*EdgeResult, error) {
+	request.GroupID = c.groupID(request.GroupID)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	var result EdgeResult
+	if err := c.do(http.MethodPost, "/entity-edge", request, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // DeleteEntityEdge deletes an entity edge by UUID
 func (c *Client) DeleteEntityEdge(uuid string) (*Result, error) {
 	var result Result
@@ -165,6 +918,29 @@ func (c *Client) DeleteEntityEdge(uuid string) (*Result, error) {
 	return &result, nil
 }
 
+// ListGroups retrieves all known groups
+func (c *Client) ListGroups() ([]GroupInfo, error) {
+	var result []GroupInfo
+	if err := c.do(http.MethodGet, "/groups", nil, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetCommunities retrieves the community nodes of a group with their summaries.
+// It returns ErrUnsupported if the server does not expose communities.
+func (c *Client) GetCommunities(groupID string) ([]CommunityResult, error) {
+	var result []CommunityResult
+	path := fmt.Sprintf("/communities/%s", url.PathEscape(groupID))
+	if err := c.do(http.MethodGet, path, nil, &result); err != nil {
+		if isMissing(err) {
+			return nil, fmt.Errorf("communities of group %s: %w", groupID, ErrUnsupported)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
 // DeleteGroup deletes a group by ID
 func (c *Client) DeleteGroup(groupID string) (*Result, error) {
 	var result Result
@@ -175,6 +951,47 @@ func (c *Client) DeleteGroup(groupID string) (*Result, error) {
 	return &result, nil
 }
 
+// DeleteGroups deletes multiple groups concurrently, at most
+// deleteGroupsParallelism at a time. Individual failures do not stop the
+// remaining deletions; they are returned in a map keyed by group ID, which is
+// empty when every group was deleted. Groups not deleted because ctx is done
+// are reported with ctx's error, which is also returned.
+func (c *Client) DeleteGroups(ctx context.Context, groupIDs []string) (map[string]error, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		failures = make(map[string]error)
+		sem      = make(chan struct{}, deleteGroupsParallelism)
+	)
+
+	for _, groupID := range groupIDs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			failures[groupID] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(groupID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			path := fmt.Sprintf("/group/%s", url.PathEscape(groupID))
+			if err := c.doContext(ctx, http.MethodDelete, path, nil, nil); err != nil {
+				mu.Lock()
+				failures[groupID] = err
+				mu.Unlock()
+			}
+		}(groupID)
+	}
+	wg.Wait()
+
+	return failures, ctx.Err()
+}
+
 // DeleteEpisode deletes an episode by UUID
 func (c *Client) DeleteEpisode(uuid string) (*Result, error) {
 	var result Result
@@ -185,8 +1002,86 @@ func (c *Client) DeleteEpisode(uuid string) (*Result, error) {
 	return &result, nil
 }
 
-// Clear clears all data from the graph
+// DeleteEpisodesBefore deletes the episodes of a group that are valid before
+// the given time, e.g. to enforce a retention policy. The group's episodes are
+// paged through first and the matching ones deleted concurrently, at most
+// deleteEpisodesParallelism at a time. It returns the number of deleted
+// episodes; individual failures do not stop the remaining deletions and are
+// returned in a *PartialError keyed by episode UUID. Episodes not deleted
+// because ctx is done are reported with ctx's error. It returns
+// ErrUnsupported if the server cannot page episodes.
+func (c *Client) DeleteEpisodesBefore(ctx context.Context, groupID string, before time.Time) (int, error) {
+	var uuids []string
+	err := c.eachEpisode(ctx, groupID, func(episode Episode) error {
+		if episode.ValidAt.Before(before) {
+			uuids = append(uuids, episode.UUID)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list episodes: %w", err)
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		deleted  int
+		failures = make(map[string]error)
+		sem      = make(chan struct{}, deleteEpisodesParallelism)
+	)
+
+	for _, uuid := range uuids {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			failures[uuid] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(uuid string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			path := fmt.Sprintf("/episode/%s", url.PathEscape(uuid))
+			err := c.doContext(ctx, http.MethodDelete, path, nil, nil)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failures[uuid] = err
+				return
+			}
+			deleted++
+		}(uuid)
+	}
+	wg.Wait()
+
+	if len(failures) > 0 {
+		return deleted, &PartialError{Failures: failures}
+	}
+	return deleted, nil
+}
+
+// EpisodeExists reports whether an episode exists without downloading it.
+// It returns ErrUnsupported if the server cannot look up single episodes.
+func (c *Client) EpisodeExists(uuid string) (bool, error) {
+	exists, err := c.exists(fmt.Sprintf("/episode/%s", url.PathEscape(uuid)))
+	if statusCode(err) == http.StatusMethodNotAllowed {
+		return false, fmt.Errorf("lookup of episode %s: %w", uuid, ErrUnsupported)
+	}
+	return exists, err
+}
+
+// Clear clears all data from the graph. It returns ErrDestructiveDisabled
+// unless the client was created with WithDestructiveOperationsEnabled.
 func (c *Client) Clear() (*Result, error) {
+	if !c.destructive {
+		return nil, ErrDestructiveDisabled
+	}
+
 	var result Result
 	if err := c.do(http.MethodPost, "/clear", nil, &result); err != nil {
 		return nil, err
@@ -194,12 +1089,135 @@ func (c *Client) Clear() (*Result, error) {
 	return &result, nil
 }
 
+// ReindexGroup triggers a reindex of the group's embeddings (asynchronous operation).
+// It returns ErrUnsupported if the server does not expose the reindex endpoint.
+func (c *Client) ReindexGroup(groupID string) (*Result, error) {
+	if err := c.requireFeature(context.Background(), FeatureReindex); err != nil {
+		return nil, err
+	}
+
+	var result Result
+	path := fmt.Sprintf("/reindex/%s", url.PathEscape(groupID))
+	if err := c.do(http.MethodPost, path, nil, &result); err != nil {
+		if isMissing(err) {
+			return nil, fmt.Errorf("reindex group %s: %w", groupID, ErrUnsupported)
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetReindexStatus retrieves the status of the group's latest reindex
+func (c *Client) GetReindexStatus(groupID string) (*ReindexStatus, error) {
+	return c.getReindexStatus(context.Background(), groupID)
+}
+
+func (c *Client) getReindexStatus(ctx context.Context, groupID string) (*ReindexStatus, error) {
+	if err := c.requireFeature(ctx, FeatureReindex); err != nil {
+		return nil, err
+	}
+
+	var result ReindexStatus
+	path := fmt.Sprintf("/reindex/%s", url.PathEscape(groupID))
+	if err := c.doContext(ctx, http.MethodGet, path, nil, &result); err != nil {
+		if isMissing(err) {
+			return nil, fmt.Errorf("reindex status of group %s: %w", groupID, ErrUnsupported)
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// WaitForReindex polls the reindex status of the group until it completes,
+// like WaitForJob. It returns the final status together with an error if the
+// reindex failed, ErrPollTimeout when the attempts or the timeout are
+// exhausted and an error wrapping the context error as soon as ctx is done.
+// Errors fetching the status, including ErrUnsupported, are returned at once.
+func (c *Client) WaitForReindex(ctx context.Context, groupID string, opts ...PollOption) (*ReindexStatus, error) {
+	p := newPoller(opts)
+	for {
+		status, err := c.getReindexStatus(withoutCache(ctx), groupID)
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("waiting for reindex of group %s: %w", groupID, ctx.Err())
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch status.Status {
+		case ReindexStatusCompleted:
+			return status, nil
+		case ReindexStatusFailed:
+			return status, fmt.Errorf("reindex of group %s failed: %s", groupID, status.Message)
+		}
+
+		more, err := p.next(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("waiting for reindex of group %s: %w", groupID, err)
+		}
+		if !more {
+			return status, fmt.Errorf("%w: reindex of group %s not completed after %d attempts",
+				ErrPollTimeout, groupID, p.attempt)
+		}
+	}
+}
+
+// GetPendingJobs retrieves ingestion jobs for a group that have not completed yet.
+// It returns ErrUnsupported if the server does not expose job inspection.
+func (c *Client) GetPendingJobs(groupID string) ([]JobStatus, error) {
+	if err := c.requireFeature(context.Background(), FeatureJobs); err != nil {
+		return nil, err
+	}
+
+	var result []JobStatus
+	path := fmt.Sprintf("/jobs/%s", url.PathEscape(groupID))
+	if err := c.do(http.MethodGet, path, nil, &result); err != nil {
+		if isMissing(err) {
+			return nil, fmt.Errorf("pending jobs of group %s: %w", groupID, ErrUnsupported)
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetJobStatus retrieves the status of an ingestion job by the ID returned in
+// AddMessagesResponse.JobID. It returns an APIError matching IsNotFound if the
+// job is unknown or the server does not track jobs.
+func (c *Client) GetJobStatus(jobID string) (*JobStatus, error) {
+	return c.getJobStatus(context.Background(), jobID)
+}
+
+func (c *Client) getJobStatus(ctx context.Context, jobID string) (*JobStatus, error) {
+	if jobID == "" {
+		return nil, invalidf("job id is required")
+	}
+
+	var result JobStatus
+	path := fmt.Sprintf("/job/%s", url.PathEscape(jobID))
+	if err := c.doContext(ctx, http.MethodGet, path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // Advanced Search Methods
 
 // TemporalWindowSearch searches for context within a specific time window
 func (c *Client) TemporalWindowSearch(request TemporalSearchRequest) (*TemporalSearchResponse, error) {
+	return c.temporalWindowSearch(context.Background(), request)
+}
+
+func (c *Client) temporalWindowSearch(ctx context.Context, request TemporalSearchRequest) (*TemporalSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
 	var result TemporalSearchResponse
-	if err := c.do(http.MethodPost, "/search/temporal-window", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/temporal-window", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -207,17 +1225,65 @@ func (c *Client) TemporalWindowSearch(request TemporalSearchRequest) (*TemporalS
 
 // EntityRelationshipsSearch finds relationships and related entities from a center node
 func (c *Client) EntityRelationshipsSearch(request EntityRelationshipSearchRequest) (*EntityRelationshipSearchResponse, error) {
+	return c.entityRelationshipsSearch(context.Background(), request)
+}
+
+func (c *Client) entityRelationshipsSearch(ctx context.Context, request EntityRelationshipSearchRequest) (*EntityRelationshipSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
 	var result EntityRelationshipSearchResponse
-	if err := c.do(http.MethodPost, "/search/entity-relationships", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/entity-relationships", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetEdgesBetween returns the direct edges connecting two nodes in either direction.
+// It runs a depth-1 relationship search centered on nodeA and keeps the edges
+// whose endpoints are exactly nodeA and nodeB.
+func (c *Client) GetEdgesBetween(nodeA, nodeB string, groupID *string) ([]EdgeResult, error) {
+	response, err := c.EntityRelationshipsSearch(EntityRelationshipSearchRequest{
+		GroupID:        groupID,
+		CenterNodeUUID: nodeA,
+		MaxDepth:       1,
+		MaxResults:     edgesBetweenMaxResults,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var edges []EdgeResult
+	for _, edge := range response.Edges {
+		if (edge.SourceNodeUUID == nodeA && edge.TargetNodeUUID == nodeB) ||
+			(edge.SourceNodeUUID == nodeB && edge.TargetNodeUUID == nodeA) {
+			edges = append(edges, edge)
+		}
+	}
+	return edges, nil
+}
+
 // DiverseResultsSearch gets diverse, non-redundant results using MMR
 func (c *Client) DiverseResultsSearch(request DiverseSearchRequest) (*DiverseSearchResponse, error) {
+	return c.diverseResultsSearch(context.Background(), request)
+}
+
+func (c *Client) diverseResultsSearch(ctx context.Context, request DiverseSearchRequest) (*DiverseSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
 	var result DiverseSearchResponse
-	if err := c.do(http.MethodPost, "/search/diverse-results", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/diverse-results", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -225,8 +1291,20 @@ func (c *Client) DiverseResultsSearch(request DiverseSearchRequest) (*DiverseSea
 
 // EpisodeContextSearch searches through agent responses and tool execution records
 func (c *Client) EpisodeContextSearch(request EpisodeContextSearchRequest) (*EpisodeContextSearchResponse, error) {
+	return c.episodeContextSearch(context.Background(), request)
+}
+
+func (c *Client) episodeContextSearch(ctx context.Context, request EpisodeContextSearchRequest) (*EpisodeContextSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
 	var result EpisodeContextSearchResponse
-	if err := c.do(http.MethodPost, "/search/episode-context", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/episode-context", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -234,8 +1312,20 @@ func (c *Client) EpisodeContextSearch(request EpisodeContextSearchRequest) (*Epi
 
 // SuccessfulToolsSearch finds successful tool executions and attack patterns
 func (c *Client) SuccessfulToolsSearch(request SuccessfulToolsSearchRequest) (*SuccessfulToolsSearchResponse, error) {
+	return c.successfulToolsSearch(context.Background(), request)
+}
+
+func (c *Client) successfulToolsSearch(ctx context.Context, request SuccessfulToolsSearchRequest) (*SuccessfulToolsSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
 	var result SuccessfulToolsSearchResponse
-	if err := c.do(http.MethodPost, "/search/successful-tools", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/successful-tools", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -243,8 +1333,24 @@ func (c *Client) SuccessfulToolsSearch(request SuccessfulToolsSearchRequest) (*S
 
 // RecentContextSearch retrieves recent relevant context
 func (c *Client) RecentContextSearch(request RecentContextSearchRequest) (*RecentContextSearchResponse, error) {
+	return c.recentContextSearch(context.Background(), request)
+}
+
+func (c *Client) recentContextSearch(ctx context.Context, request RecentContextSearchRequest) (*RecentContextSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
+	if request.RecencyDuration > 0 {
+		request.RecencyWindow = formatRecencyWindow(request.RecencyDuration)
+	}
+
 	var result RecentContextSearchResponse
-	if err := c.do(http.MethodPost, "/search/recent-context", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/recent-context", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -252,9 +1358,61 @@ func (c *Client) RecentContextSearch(request RecentContextSearchRequest) (*Recen
 
 // EntityByLabelSearch searches for entities by label/type with optional edge filtering
 func (c *Client) EntityByLabelSearch(request EntityByLabelSearchRequest) (*EntityByLabelSearchResponse, error) {
+	return c.entityByLabelSearch(context.Background(), request)
+}
+
+func (c *Client) entityByLabelSearch(ctx context.Context, request EntityByLabelSearchRequest) (*EntityByLabelSearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
 	var result EntityByLabelSearchResponse
-	if err := c.do(http.MethodPost, "/search/entity-by-label", request, &result); err != nil {
+	if err := c.doContext(ctx, http.MethodPost, "/search/entity-by-label", request, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
+
+// CommunitySearch searches community nodes by their names and summaries
+func (c *Client) CommunitySearch(request CommunitySearchRequest) (*CommunitySearchResponse, error) {
+	return c.communitySearch(context.Background(), request)
+}
+
+func (c *Client) communitySearch(ctx context.Context, request CommunitySearchRequest) (*CommunitySearchResponse, error) {
+	request.GroupID, request.GroupIDs = c.searchGroups(request.GroupID, request.GroupIDs)
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+	if err := c.requireFeature(ctx, FeatureCommunities); err != nil {
+		return nil, err
+	}
+
+	var result CommunitySearchResponse
+	if err := c.doContext(ctx, http.MethodPost, "/search/communities", request, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// formatRecencyWindow converts a duration to the server's recency window form
+// ("90m", "6h", "7d"), using the largest unit that represents it exactly.
+// Durations are rounded up to whole minutes; a single day is kept as "24h".
+// Zero and negative durations have no window and return "".
+func formatRecencyWindow(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	minutes := int64((d + time.Minute - 1) / time.Minute)
+	switch {
+	case minutes%(24*60) == 0 && minutes > 24*60:
+		return fmt.Sprintf("%dd", minutes/(24*60))
+	case minutes%60 == 0:
+		return fmt.Sprintf("%dh", minutes/60)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
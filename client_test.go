@@ -0,0 +1,111 @@
+package graphiti_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestBaseURLPathPrefix(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	for _, prefix := range []string{"/api/graphiti", "/api/graphiti/"} {
+		gotPath = ""
+		client := graphiti.NewClient(server.URL+prefix, graphiti.WithHTTPClient(server.Client()))
+		if _, err := client.HealthCheck(); err != nil {
+			t.Fatalf("base URL %q: HealthCheck: %v", server.URL+prefix, err)
+		}
+		if want := "/api/graphiti/healthcheck"; gotPath != want {
+			t.Errorf("base URL %q: request path = %q, want %q", server.URL+prefix, gotPath, want)
+		}
+	}
+}
+
+func TestTrailingSlashReplaysBodyAfterRedirect(t *testing.T) {
+	var received graphiti.AddMessagesRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("/messages/", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ingest/messages/", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/ingest/messages/", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"message": "queued", "success": true}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := graphiti.NewClient(server.URL, graphiti.WithHTTPClient(server.Client()), graphiti.WithTrailingSlash())
+	request := graphiti.AddMessagesRequest{
+		GroupID:  "g",
+		Messages: []graphiti.Message{{Content: "hello", Author: "alice", Timestamp: time.Now().UTC()}},
+	}
+	if _, err := client.AddMessages(request); err != nil {
+		t.Fatalf("AddMessages: %v", err)
+	}
+
+	if received.GroupID != "g" || len(received.Messages) != 1 || received.Messages[0].Content != "hello" {
+		t.Errorf("body after redirect = %+v, want the original request", received)
+	}
+}
+
+func TestUpdateEntityNode(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	client := server.Client()
+
+	created, err := client.AddEntityNode(graphiti.AddEntityNodeRequest{
+		UUID:    "node-1",
+		GroupID: "g",
+		Name:    "10.0.0.1",
+		Summary: "unscanned host",
+	})
+	if err != nil {
+		t.Fatalf("AddEntityNode: %v", err)
+	}
+
+	updated, err := client.UpdateEntityNode(graphiti.UpdateEntityNodeRequest{
+		UUID:    created.UUID,
+		Summary: "host with ssh open",
+	})
+	if err != nil {
+		t.Fatalf("UpdateEntityNode: %v", err)
+	}
+	if updated.Summary != "host with ssh open" {
+		t.Errorf("Summary = %q, want %q", updated.Summary, "host with ssh open")
+	}
+	if updated.Name != created.Name {
+		t.Errorf("Name = %q, want unchanged %q", updated.Name, created.Name)
+	}
+	if stored, ok := server.EntityNode(created.UUID); !ok || stored.Summary != updated.Summary {
+		t.Errorf("stored node = %+v, want summary %q", stored, updated.Summary)
+	}
+}
+
+func TestUpdateEntityNodeNotFound(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	_, err := server.Client().UpdateEntityNode(graphiti.UpdateEntityNodeRequest{
+		UUID:    "missing",
+		Summary: "anything",
+	})
+	if !graphiti.IsNotFound(err) {
+		t.Errorf("error = %v, want not found", err)
+	}
+}
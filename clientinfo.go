@@ -0,0 +1,71 @@
+package graphiti
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// Version is the version of this client library
+const Version = "0.1.0"
+
+const (
+	modulePath = "github.com/vxcontrol/graphiti-go-client"
+	clientName = "graphiti-go-client"
+)
+
+// DefaultUserAgent is the User-Agent sent unless overridden with WithUserAgent
+const DefaultUserAgent = clientName + "/" + Version
+
+// ClientInfo describes the client software, sent to the server for telemetry
+type ClientInfo struct {
+	Name      string
+	Version   string
+	GoVersion string
+	OS        string
+}
+
+// String formats the client info as the X-Client-Info header value
+func (i ClientInfo) String() string {
+	return fmt.Sprintf("name=%s; version=%s; go=%s; os=%s", i.Name, i.Version, i.GoVersion, i.OS)
+}
+
+// BuildInfo is the default client info, populated from the binary's build information
+var BuildInfo = readBuildInfo()
+
+// WithUserAgent overrides the User-Agent header sent with every request
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithClientInfo overrides the client info sent in the X-Client-Info header
+func WithClientInfo(info ClientInfo) ClientOption {
+	return func(c *Client) {
+		c.clientInfo = info.String()
+	}
+}
+
+func readBuildInfo() ClientInfo {
+	info := ClientInfo{
+		Name:      clientName,
+		Version:   Version,
+		GoVersion: runtime.Version(),
+		OS:        runtime.GOOS + "/" + runtime.GOARCH,
+	}
+
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	for _, dep := range bi.Deps {
+		if dep.Path == modulePath && dep.Version != "" && dep.Version != "(devel)" {
+			info.Version = dep.Version
+			break
+		}
+	}
+
+	return info
+}
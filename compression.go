@@ -0,0 +1,78 @@
+package graphiti
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// WithCompression gzips request bodies (Content-Encoding: gzip) and asks the
+// server for gzipped responses, which are decompressed transparently
+func WithCompression() ClientOption {
+	return func(c *Client) {
+		c.compression = true
+	}
+}
+
+// gzipBytes compresses data with gzip
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// gzipReadCloser closes both the gzip reader and the underlying body
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.Closer
+}
+
+func (r *gzipReadCloser) Close() error {
+	r.Reader.Close()
+	return r.body.Close()
+}
+
+// bufferedBody keeps the original body closer behind a buffered reader
+type bufferedBody struct {
+	*bufio.Reader
+	io.Closer
+}
+
+// decompressBody replaces a gzip-encoded response body with a decompressing
+// reader. Bodies that are labeled gzip but are not actually compressed are
+// passed through unchanged.
+func decompressBody(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	br := bufio.NewReader(resp.Body)
+	magic, err := br.Peek(2)
+	if err != nil || magic[0] != 0x1f || magic[1] != 0x8b {
+		// empty or uncompressed body despite the header
+		resp.Body = bufferedBody{Reader: br, Closer: resp.Body}
+		return nil
+	}
+
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		return err
+	}
+
+	resp.Body = &gzipReadCloser{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package graphiti_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+// loadAdvancedSearchMessages returns the 7 messages of the advanced search example
+func loadAdvancedSearchMessages(t *testing.T) []graphiti.Message {
+	t.Helper()
+	data, err := os.ReadFile("testdata/advanced_search_messages.json")
+	if err != nil {
+		t.Fatalf("failed to read dataset: %v", err)
+	}
+	var messages []graphiti.Message
+	if err := json.Unmarshal(data, &messages); err != nil {
+		t.Fatalf("failed to decode dataset: %v", err)
+	}
+	return messages
+}
+
+func TestCompressionReducesPayload(t *testing.T) {
+	var wire, plain []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "gzip" {
+			http.Error(w, "request body is not gzipped", http.StatusBadRequest)
+			return
+		}
+		wire, _ = io.ReadAll(r.Body)
+		zr, err := gzip.NewReader(bytes.NewReader(wire))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		plain, _ = io.ReadAll(zr)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"message": "queued", "success": true}`))
+	}))
+	defer server.Close()
+
+	request := graphiti.AddMessagesRequest{GroupID: "g", Messages: loadAdvancedSearchMessages(t)}
+	client := graphiti.NewClient(server.URL, graphiti.WithHTTPClient(server.Client()), graphiti.WithCompression())
+	if _, err := client.AddMessages(request); err != nil {
+		t.Fatalf("AddMessages: %v", err)
+	}
+
+	var decoded graphiti.AddMessagesRequest
+	if err := json.Unmarshal(plain, &decoded); err != nil {
+		t.Fatalf("decompressed body is not the request: %v", err)
+	}
+	if len(decoded.Messages) != len(request.Messages) || decoded.Messages[6].Content != request.Messages[6].Content {
+		t.Errorf("decompressed request does not match the dataset")
+	}
+
+	reduction := 100 * (1 - float64(len(wire))/float64(len(plain)))
+	t.Logf("advanced search dataset: %d bytes plain, %d bytes gzipped (%.1f%% smaller)", len(plain), len(wire), reduction)
+	if reduction < 50 {
+		t.Errorf("gzip reduced the payload by %.1f%%, want at least 50%%", reduction)
+	}
+}
+
+func TestCompressionDecompressesResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		gzip bool
+	}{
+		{"gzipped", true},
+		{"uncompressed despite header", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Accept-Encoding") != "gzip" {
+					t.Errorf("Accept-Encoding = %q, want gzip", r.Header.Get("Accept-Encoding"))
+				}
+				body := []byte(`{"status": "healthy"}`)
+				if tt.gzip {
+					var buf bytes.Buffer
+					zw := gzip.NewWriter(&buf)
+					_, _ = zw.Write(body)
+					_ = zw.Close()
+					body = buf.Bytes()
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", "gzip")
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+
+			client := graphiti.NewClient(server.URL, graphiti.WithHTTPClient(server.Client()), graphiti.WithCompression())
+			health, err := client.HealthCheck()
+			if err != nil {
+				t.Fatalf("HealthCheck: %v", err)
+			}
+			if health.Status != "healthy" {
+				t.Errorf("Status = %q, want healthy", health.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package graphiti
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// Duration is a time.Duration that decodes from strings such as "30s" or
+// "1m30s" in JSON, YAML and other text formats. JSON numbers are read as
+// nanoseconds, like a plain time.Duration.
+type Duration time.Duration
+
+// MarshalText encodes the duration in time.Duration's string form
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText parses the duration with time.ParseDuration
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", text, err)
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// UnmarshalJSON accepts a duration string or a number of nanoseconds
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var nanoseconds int64
+	if err := json.Unmarshal(data, &nanoseconds); err == nil {
+		*d = Duration(nanoseconds)
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return fmt.Errorf("invalid duration %s: must be a string such as \"30s\" or nanoseconds", data)
+	}
+	return d.UnmarshalText([]byte(text))
+}
+
+// ClientConfig is a plain configuration struct for creating a Client,
+// an alternative to functional options for config-driven deployments.
+// Durations are written as strings such as "30s" in JSON and YAML.
+type ClientConfig struct {
+	BaseURL         string            `json:"base_url" yaml:"base_url"`
+	Timeout         Duration          `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	APIKey          string            `json:"api_key,omitempty" yaml:"api_key,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	MaxMessageBytes int               `json:"max_message_bytes,omitempty" yaml:"max_message_bytes,omitempty"`
+	TrailingSlash   bool              `json:"trailing_slash,omitempty" yaml:"trailing_slash,omitempty"`
+	Compression     bool              `json:"compression,omitempty" yaml:"compression,omitempty"`
+	Retries         int               `json:"retries,omitempty" yaml:"retries,omitempty"`
+	RetryBaseDelay  Duration          `json:"retry_base_delay,omitempty" yaml:"retry_base_delay,omitempty"`
+	RetryWrites     bool              `json:"retry_writes,omitempty" yaml:"retry_writes,omitempty"`
+	RateLimit       float64           `json:"rate_limit,omitempty" yaml:"rate_limit,omitempty"`
+	RateBurst       int               `json:"rate_burst,omitempty" yaml:"rate_burst,omitempty"`
+	CacheTTL        Duration          `json:"cache_ttl,omitempty" yaml:"cache_ttl,omitempty"`
+	RootCAFile      string            `json:"root_ca_file,omitempty" yaml:"root_ca_file,omitempty"`
+	InsecureTLS     bool              `json:"insecure_tls,omitempty" yaml:"insecure_tls,omitempty"`
+	DefaultGroupID  string            `json:"default_group_id,omitempty" yaml:"default_group_id,omitempty"`
+	MaxIdleConns    int               `json:"max_idle_conns,omitempty" yaml:"max_idle_conns,omitempty"`
+	MaxConnsPerHost int               `json:"max_conns_per_host,omitempty" yaml:"max_conns_per_host,omitempty"`
+	KeepAlive       Duration          `json:"keep_alive,omitempty" yaml:"keep_alive,omitempty"`
+	BreakerFailures int               `json:"breaker_failures,omitempty" yaml:"breaker_failures,omitempty"`
+	BreakerOpenFor  Duration          `json:"breaker_open_for,omitempty" yaml:"breaker_open_for,omitempty"`
+	StrictDecoding  bool              `json:"strict_decoding,omitempty" yaml:"strict_decoding,omitempty"`
+	UTCTimestamps   bool              `json:"utc_timestamps,omitempty" yaml:"utc_timestamps,omitempty"`
+	TimeFormat      string            `json:"time_format,omitempty" yaml:"time_format,omitempty"`
+	HTTPClient      *http.Client      `json:"-" yaml:"-"`
+}
+
+// ValidateBaseURL checks that baseURL is an absolute http or https URL with a
+// host, such as "http://localhost:8000" or "https://host/api/graphiti/"
+func ValidateBaseURL(baseURL string) error {
+	if baseURL == "" {
+		return fmt.Errorf("base URL is required")
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid base URL %q: scheme must be http or https", baseURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid base URL %q: host is required", baseURL)
+	}
+	return nil
+}
+
+// Validate checks that the configuration is usable
+func (cfg ClientConfig) Validate() error {
+	if err := ValidateBaseURL(cfg.BaseURL); err != nil {
+		return err
+	}
+	if cfg.RootCAFile != "" {
+		if _, err := os.Stat(cfg.RootCAFile); err != nil {
+			return fmt.Errorf("invalid root CA file: %w", err)
+		}
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative")
+	}
+	if cfg.Retries < 0 {
+		return fmt.Errorf("retries must not be negative")
+	}
+	if cfg.RetryBaseDelay < 0 {
+		return fmt.Errorf("retry base delay must not be negative")
+	}
+	if cfg.RateLimit < 0 {
+		return fmt.Errorf("rate limit must not be negative")
+	}
+	if cfg.RateLimit > 0 && cfg.RateBurst <= 0 {
+		return fmt.Errorf("rate burst must be positive when rate limiting is enabled")
+	}
+	if cfg.CacheTTL < 0 {
+		return fmt.Errorf("cache TTL must not be negative")
+	}
+	if cfg.MaxMessageBytes < 0 {
+		return fmt.Errorf("max message bytes must not be negative")
+	}
+	if cfg.MaxIdleConns < 0 {
+		return fmt.Errorf("max idle connections must not be negative")
+	}
+	if cfg.MaxConnsPerHost < 0 {
+		return fmt.Errorf("max connections per host must not be negative")
+	}
+	if cfg.BreakerFailures < 0 {
+		return fmt.Errorf("breaker failures must not be negative")
+	}
+	if cfg.BreakerFailures > 0 && cfg.BreakerOpenFor <= 0 {
+		return fmt.Errorf("breaker open duration must be positive when the circuit breaker is enabled")
+	}
+	return nil
+}
+
+// options converts the configuration to functional options
+func (cfg ClientConfig) options() []ClientOption {
+	var opts []ClientOption
+	if cfg.HTTPClient != nil {
+		opts = append(opts, WithHTTPClient(cfg.HTTPClient))
+	}
+	if cfg.Timeout > 0 {
+		opts = append(opts, WithTimeout(time.Duration(cfg.Timeout)))
+	}
+	if cfg.RootCAFile != "" {
+		opts = append(opts, WithRootCAFile(cfg.RootCAFile))
+	}
+	if cfg.InsecureTLS {
+		opts = append(opts, WithInsecureSkipVerify())
+	}
+	for key, value := range cfg.Headers {
+		opts = append(opts, WithHeader(key, value))
+	}
+	if cfg.APIKey != "" {
+		opts = append(opts, WithAPIKey(cfg.APIKey))
+	}
+	if cfg.MaxMessageBytes > 0 {
+		opts = append(opts, WithMaxMessageBytes(cfg.MaxMessageBytes))
+	}
+	if cfg.TrailingSlash {
+		opts = append(opts, WithTrailingSlash())
+	}
+	if cfg.Compression {
+		opts = append(opts, WithCompression())
+	}
+	if cfg.Retries > 0 {
+		opts = append(opts, WithRetry(cfg.Retries, time.Duration(cfg.RetryBaseDelay)))
+	}
+	if cfg.RetryWrites {
+		opts = append(opts, WithRetryWrites())
+	}
+	if cfg.RateLimit > 0 {
+		opts = append(opts, WithRateLimit(cfg.RateLimit, cfg.RateBurst))
+	}
+	if cfg.CacheTTL > 0 {
+		opts = append(opts, WithCache(time.Duration(cfg.CacheTTL)))
+	}
+	if cfg.DefaultGroupID != "" {
+		opts = append(opts, WithDefaultGroupID(cfg.DefaultGroupID))
+	}
+	if cfg.MaxIdleConns > 0 {
+		opts = append(opts, WithMaxIdleConns(cfg.MaxIdleConns))
+	}
+	if cfg.MaxConnsPerHost > 0 {
+		opts = append(opts, WithMaxConnsPerHost(cfg.MaxConnsPerHost))
+	}
+	if cfg.KeepAlive != 0 {
+		opts = append(opts, WithKeepAlive(time.Duration(cfg.KeepAlive)))
+	}
+	if cfg.BreakerFailures > 0 {
+		opts = append(opts, WithCircuitBreaker(cfg.BreakerFailures, time.Duration(cfg.BreakerOpenFor)))
+	}
+	if cfg.StrictDecoding {
+		opts = append(opts, WithStrictDecoding())
+	}
+	if cfg.UTCTimestamps {
+		opts = append(opts, WithUTCTimestamps())
+	}
+	if cfg.TimeFormat != "" {
+		opts = append(opts, WithTimeFormat(cfg.TimeFormat))
+	}
+	return opts
+}
+
+// NewClientWithConfig validates the configuration and creates a new Graphiti API client
+func NewClientWithConfig(cfg ClientConfig) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid client config: %w", err)
+	}
+	return NewClient(cfg.BaseURL, cfg.options()...), nil
+}
@@ -0,0 +1,82 @@
+package graphiti_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+func TestClientConfigDurations(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"string", `{"timeout": "30s"}`, 30 * time.Second, false},
+		{"compound string", `{"timeout": "1m30s"}`, 90 * time.Second, false},
+		{"nanoseconds", `{"timeout": 1000000000}`, time.Second, false},
+		{"invalid string", `{"timeout": "soon"}`, 0, true},
+		{"invalid type", `{"timeout": true}`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg graphiti.ClientConfig
+			err := json.Unmarshal([]byte(tt.json), &cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("decoded %s without error", tt.json)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got := time.Duration(cfg.Timeout); got != tt.want {
+				t.Errorf("Timeout = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationText(t *testing.T) {
+	var d graphiti.Duration
+	if err := d.UnmarshalText([]byte("500ms")); err != nil {
+		t.Fatalf("UnmarshalText: %v", err)
+	}
+	if time.Duration(d) != 500*time.Millisecond {
+		t.Errorf("duration = %s, want 500ms", time.Duration(d))
+	}
+	text, err := d.MarshalText()
+	if err != nil || string(text) != "500ms" {
+		t.Errorf("MarshalText = %q, %v, want 500ms", text, err)
+	}
+}
+
+func TestClientConfigRetryBaseDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		delay   time.Duration
+		wantErr bool
+	}{
+		{"zero retries immediately", 0, false},
+		{"positive", time.Second, false},
+		{"negative", -time.Second, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := graphiti.ClientConfig{
+				BaseURL:        "http://localhost:8000",
+				Retries:        3,
+				RetryBaseDelay: graphiti.Duration(tt.delay),
+			}
+			if err := cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
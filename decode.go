@@ -0,0 +1,25 @@
+package graphiti
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// WithStrictDecoding makes responses containing fields the client does not
+// know fail to decode instead of ignoring them, to catch schema drift between
+// client and server during development and testing. Leave it off in
+// production so newer servers that add fields keep working.
+func WithStrictDecoding() ClientOption {
+	return func(c *Client) {
+		c.strictDecoding = true
+	}
+}
+
+// newDecoder returns a JSON decoder for a response body that honors WithStrictDecoding
+func (c *Client) newDecoder(r io.Reader) *json.Decoder {
+	dec := json.NewDecoder(r)
+	if c.strictDecoding {
+		dec.DisallowUnknownFields()
+	}
+	return dec
+}
@@ -0,0 +1,44 @@
+package graphiti
+
+// WithDefaultGroupID sets the group used by requests that leave their group
+// empty: GroupID fields that are "" or nil, and SearchQuery.GroupIDs when nil.
+// A group set explicitly on the request always wins.
+func WithDefaultGroupID(groupID string) ClientOption {
+	return func(c *Client) {
+		c.defaultGroupID = groupID
+	}
+}
+
+// groupID returns groupID, or the default group when it is empty
+func (c *Client) groupID(groupID string) string {
+	if groupID == "" {
+		return c.defaultGroupID
+	}
+	return groupID
+}
+
+// groupIDPtr returns groupID, or a pointer to the default group when it is nil
+func (c *Client) groupIDPtr(groupID *string) *string {
+	if groupID == nil && c.defaultGroupID != "" {
+		defaultGroupID := c.defaultGroupID
+		return &defaultGroupID
+	}
+	return groupID
+}
+
+// groupIDs returns groupIDs, or a list holding only the default group when it is nil
+func (c *Client) groupIDs(groupIDs *[]string) *[]string {
+	if groupIDs == nil && c.defaultGroupID != "" {
+		return &[]string{c.defaultGroupID}
+	}
+	return groupIDs
+}
+
+// searchGroups resolves the groups of an advanced search: GroupIDs wins over
+// GroupID, which falls back to the default group when both are nil
+func (c *Client) searchGroups(groupID *string, groupIDs *[]string) (*string, *[]string) {
+	if groupIDs != nil {
+		return nil, groupIDs
+	}
+	return c.groupIDPtr(groupID), nil
+}
@@ -0,0 +1,196 @@
+package graphiti
+
+import (
+	"reflect"
+	"slices"
+	"time"
+)
+
+// GraphDiff is the difference between two graph exports. Added items are in
+// the order of the newer export, removed and changed items in the order of
+// the older one.
+type GraphDiff struct {
+	AddedNodes   []NodeResult `json:"added_nodes"`
+	RemovedNodes []NodeResult `json:"removed_nodes"`
+	ChangedNodes []NodeChange `json:"changed_nodes"`
+	AddedEdges   []EdgeResult `json:"added_edges"`
+	RemovedEdges []EdgeResult `json:"removed_edges"`
+	ChangedEdges []EdgeChange `json:"changed_edges"`
+}
+
+// NodeChange is a node present in both exports with differing fields, listed
+// by their JSON names
+type NodeChange struct {
+	Before NodeResult `json:"before"`
+	After  NodeResult `json:"after"`
+	Fields []string   `json:"fields"`
+}
+
+// EdgeChange is an edge present in both exports with differing fields, listed
+// by their JSON names
+type EdgeChange struct {
+	Before EdgeResult `json:"before"`
+	After  EdgeResult `json:"after"`
+	Fields []string   `json:"fields"`
+}
+
+// Empty reports whether the exports have the same nodes and edges
+func (d *GraphDiff) Empty() bool {
+	return len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 && len(d.ChangedNodes) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 && len(d.ChangedEdges) == 0
+}
+
+// diffConfig controls DiffGraphExports
+type diffConfig struct {
+	ignoreTimestamps bool
+}
+
+// DiffOption is a functional option for configuring DiffGraphExports
+type DiffOption func(*diffConfig)
+
+// WithIgnoreTimestamps skips created_at and expired_at, which record when the
+// server processed an item and change whenever the same messages are ingested
+// again. valid_at and invalid_at are still compared.
+func WithIgnoreTimestamps() DiffOption {
+	return func(d *diffConfig) {
+		d.ignoreTimestamps = true
+	}
+}
+
+// DiffGraphExports compares the nodes and edges of two exports, matching them
+// by UUID. Labels are compared as sets; episodes and communities are not
+// compared. A nil export is treated as empty, so the diff against nil lists
+// every item as added or removed.
+func DiffGraphExports(a, b *GraphExport, opts ...DiffOption) *GraphDiff {
+	var cfg diffConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if a == nil {
+		a = &GraphExport{}
+	}
+	if b == nil {
+		b = &GraphExport{}
+	}
+
+	diff := &GraphDiff{}
+	diff.AddedNodes, diff.RemovedNodes, diff.ChangedNodes = diffByUUID(a.Nodes, b.Nodes,
+		func(node NodeResult) string { return node.UUID },
+		func(before, after NodeResult) *NodeChange {
+			fields := cfg.nodeFields(before, after)
+			if len(fields) == 0 {
+				return nil
+			}
+			return &NodeChange{Before: before, After: after, Fields: fields}
+		})
+	diff.AddedEdges, diff.RemovedEdges, diff.ChangedEdges = diffByUUID(a.Edges, b.Edges,
+		func(edge EdgeResult) string { return edge.UUID },
+		func(before, after EdgeResult) *EdgeChange {
+			fields := cfg.edgeFields(before, after)
+			if len(fields) == 0 {
+				return nil
+			}
+			return &EdgeChange{Before: before, After: after, Fields: fields}
+		})
+
+	return diff
+}
+
+// diffByUUID splits two item lists into added, removed and changed items.
+// change returns nil for items that are equal.
+func diffByUUID[T, C any](a, b []T, uuid func(T) string, change func(before, after T) *C) (added, removed []T, changed []C) {
+	added, removed, changed = []T{}, []T{}, []C{}
+
+	after := make(map[string]T, len(b))
+	for _, item := range b {
+		after[uuid(item)] = item
+	}
+	before := make(map[string]bool, len(a))
+	for _, item := range a {
+		before[uuid(item)] = true
+		match, ok := after[uuid(item)]
+		if !ok {
+			removed = append(removed, item)
+			continue
+		}
+		if c := change(item, match); c != nil {
+			changed = append(changed, *c)
+		}
+	}
+	for _, item := range b {
+		if !before[uuid(item)] {
+			added = append(added, item)
+		}
+	}
+	return added, removed, changed
+}
+
+// nodeFields returns the JSON names of the fields that differ between two nodes
+func (d diffConfig) nodeFields(a, b NodeResult) []string {
+	var fields []string
+	if a.Name != b.Name {
+		fields = append(fields, "name")
+	}
+	if !sameSet(a.Labels, b.Labels) {
+		fields = append(fields, "labels")
+	}
+	if a.EntityType != b.EntityType {
+		fields = append(fields, "entity_type")
+	}
+	if a.Summary != b.Summary {
+		fields = append(fields, "summary")
+	}
+	if !d.ignoreTimestamps && !a.CreatedAt.Equal(b.CreatedAt) {
+		fields = append(fields, "created_at")
+	}
+	if (len(a.Attributes) != 0 || len(b.Attributes) != 0) && !reflect.DeepEqual(a.Attributes, b.Attributes) {
+		fields = append(fields, "attributes")
+	}
+	return fields
+}
+
+// edgeFields returns the JSON names of the fields that differ between two edges
+func (d diffConfig) edgeFields(a, b EdgeResult) []string {
+	var fields []string
+	if a.Name != b.Name {
+		fields = append(fields, "name")
+	}
+	if a.Fact != b.Fact {
+		fields = append(fields, "fact")
+	}
+	if a.SourceNodeUUID != b.SourceNodeUUID {
+		fields = append(fields, "source_node_uuid")
+	}
+	if a.TargetNodeUUID != b.TargetNodeUUID {
+		fields = append(fields, "target_node_uuid")
+	}
+	if !sameTime(a.ValidAt, b.ValidAt) {
+		fields = append(fields, "valid_at")
+	}
+	if !sameTime(a.InvalidAt, b.InvalidAt) {
+		fields = append(fields, "invalid_at")
+	}
+	if !d.ignoreTimestamps && !a.CreatedAt.Equal(b.CreatedAt) {
+		fields = append(fields, "created_at")
+	}
+	if !d.ignoreTimestamps && !sameTime(a.ExpiredAt, b.ExpiredAt) {
+		fields = append(fields, "expired_at")
+	}
+	return fields
+}
+
+// sameSet reports whether two string slices hold the same values in any order
+func sameSet(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// sameTime reports whether two optional times are both unset or equal
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
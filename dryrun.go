@@ -0,0 +1,67 @@
+package graphiti
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// ErrDryRun is matched by the error every request returns when the client was
+// created with WithDryRun. Use errors.As with *DryRunError to get the request.
+var ErrDryRun = errors.New("dry run: request not sent")
+
+// RequestPreview describes an HTTP request as the client would send it.
+// Body holds the bytes as sent, so it is gzip-compressed with WithCompression.
+type RequestPreview struct {
+	Method  string
+	URL     string
+	Headers http.Header
+	Body    []byte
+}
+
+// DryRunError is returned instead of sending a request when dry run is enabled
+type DryRunError struct {
+	Request *RequestPreview
+}
+
+func (e *DryRunError) Error() string {
+	return ErrDryRun.Error() + ": " + e.Request.Method + " " + e.Request.URL
+}
+
+// Is makes errors.Is(err, ErrDryRun) match
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// WithDryRun makes the client build requests without sending them. Every
+// method returns a *DryRunError holding the request that would have been
+// sent; methods that make several requests stop at the first one.
+func WithDryRun() ClientOption {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// Preview returns the request the client would send for method, path and
+// body, with all headers and the signature applied, without sending it
+func (c *Client) Preview(method, path string, body interface{}) (*RequestPreview, error) {
+	jsonData, err := c.encodeBody(body)
+	if err != nil {
+		return nil, err
+	}
+	return c.preview(context.Background(), method, path, jsonData, body != nil)
+}
+
+// preview builds the request and describes it
+func (c *Client) preview(ctx context.Context, method, path string, jsonData []byte, hasBody bool) (*RequestPreview, error) {
+	req, err := c.newRequest(ctx, method, path, jsonData, hasBody)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestPreview{
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Headers: req.Header,
+		Body:    jsonData,
+	}, nil
+}
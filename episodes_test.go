@@ -0,0 +1,54 @@
+package graphiti_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestDeleteEpisodesBefore(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 150; i++ {
+		validAt := cutoff.Add(-time.Hour)
+		if i%3 == 0 {
+			validAt = cutoff.Add(time.Hour)
+		}
+		server.AddEpisodes(graphititest.NewEpisode(fmt.Sprintf("e%d", i), "g", "content", validAt))
+	}
+
+	deleted, err := server.Client().DeleteEpisodesBefore(context.Background(), "g", cutoff)
+	if err != nil {
+		t.Fatalf("DeleteEpisodesBefore: %v", err)
+	}
+	if deleted != 100 {
+		t.Errorf("deleted %d episodes, want 100", deleted)
+	}
+	for _, episode := range server.Episodes("g") {
+		if episode.ValidAt.Before(cutoff) {
+			t.Errorf("episode %s before the cutoff was kept", episode.UUID)
+		}
+	}
+	if remaining := len(server.Episodes("g")); remaining != 50 {
+		t.Errorf("%d episodes remain, want 50", remaining)
+	}
+}
+
+func TestDeleteEpisodesBeforeUnsupported(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	server.Respond(graphititest.RouteGetEpisodesPage, http.StatusNotFound, map[string]string{"detail": "Not Found"})
+
+	_, err := server.Client().DeleteEpisodesBefore(context.Background(), "g", time.Now())
+	if !errors.Is(err, graphiti.ErrUnsupported) {
+		t.Errorf("DeleteEpisodesBefore error = %v, want ErrUnsupported", err)
+	}
+}
@@ -0,0 +1,162 @@
+package graphiti
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// ErrGroupNotFound is returned when an operation requires an existing group
+var ErrGroupNotFound = errors.New("group not found")
+
+// ErrInvalidRequest is returned when a request fails client-side validation
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ErrUnsupported is returned when the server does not implement an endpoint
+var ErrUnsupported = errors.New("operation is not supported by the server")
+
+// ErrResultsTruncated is returned with partial results when a helper that
+// collects all results stops at its safety cap
+var ErrResultsTruncated = errors.New("results truncated")
+
+// ErrNodeNotFound is returned when a node name does not match any entity node
+var ErrNodeNotFound = errors.New("node not found")
+
+// ErrEdgeNotFound is returned by EnrichFacts for facts whose edge is not in the group
+var ErrEdgeNotFound = errors.New("edge not found")
+
+// ErrAmbiguousNode is returned when a node name matches several entity nodes
+var ErrAmbiguousNode = errors.New("node name is ambiguous")
+
+// ErrMetadataKeyNotFound is returned by MetadataInto when the key is not set
+var ErrMetadataKeyNotFound = errors.New("metadata key not found")
+
+// ErrDestructiveDisabled is returned by Clear when destructive operations
+// were not enabled with WithDestructiveOperationsEnabled
+var ErrDestructiveDisabled = errors.New("destructive operations are disabled")
+
+// APIError is returned when the API responds with a non-2xx status code.
+// Message holds the server's error detail when the body is a JSON error object.
+type APIError struct {
+	StatusCode int
+	Body       string
+	Message    string
+	retryAfter string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// newAPIError builds an APIError from a failed response and its body
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	var detail struct {
+		Detail  interface{} `json:"detail"`
+		Message string      `json:"message"`
+	}
+
+	message := ""
+	if err := json.Unmarshal(body, &detail); err == nil {
+		switch d := detail.Detail.(type) {
+		case string:
+			message = d
+		case nil:
+			message = detail.Message
+		default:
+			// validation errors carry structured details
+			if b, err := json.Marshal(d); err == nil {
+				message = string(b)
+			}
+		}
+	}
+
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		Message:    message,
+		retryAfter: resp.Header.Get("Retry-After"),
+	}
+}
+
+// statusCode returns the status code of an APIError in the chain of err, or zero
+func statusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an API error with status 404
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is an API error with status 429
+func IsRateLimited(err error) bool {
+	return statusCode(err) == http.StatusTooManyRequests
+}
+
+// IsUnauthorized reports whether err is an API error with status 401 or 403
+func IsUnauthorized(err error) bool {
+	code := statusCode(err)
+	return code == http.StatusUnauthorized || code == http.StatusForbidden
+}
+
+// IsServerError reports whether err is an API error with a 5xx status
+func IsServerError(err error) bool {
+	code := statusCode(err)
+	return code >= 500 && code < 600
+}
+
+// isMissing reports whether err is an API error for a missing resource,
+// endpoint or method
+func isMissing(err error) bool {
+	code := statusCode(err)
+	return code == http.StatusNotFound || code == http.StatusMethodNotAllowed
+}
+
+// BatchError is returned when a batched operation stops part way through.
+// Index is the position of the first item that was not processed.
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("batch stopped at item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// PartialError is returned by bulk operations that fail for some keys. The
+// results for the other keys are returned alongside it. Failures maps each
+// failed key, such as a UUID, to its error.
+type PartialError struct {
+	Failures map[string]error
+}
+
+func (e *PartialError) Error() string {
+	if len(e.Failures) == 0 {
+		return "no items failed"
+	}
+	keys := make([]string, 0, len(e.Failures))
+	for key := range e.Failures {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%d items failed, including %s: %v", len(keys), keys[0], e.Failures[keys[0]])
+}
+
+// Unwrap returns the individual errors, so errors.Is and errors.As match any of them
+func (e *PartialError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, err := range e.Failures {
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -7,4 +7,6 @@ replace github.com/vxcontrol/graphiti-go-client => ../../
 require (
 	github.com/google/uuid v1.6.0
 	github.com/vxcontrol/graphiti-go-client v0.0.0-00010101000000-000000000000
-)
\ No newline at end of file
+)
+
+require golang.org/x/time v0.9.0 // indirect
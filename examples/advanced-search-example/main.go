@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -20,7 +21,9 @@ const (
 
 func main() {
 	// Create a client
-	client := graphiti.NewClient("http://localhost:8000", graphiti.WithTimeout(60*time.Second))
+	client := graphiti.NewClient("http://localhost:8000",
+		graphiti.WithTimeout(60*time.Second),
+		graphiti.WithUTCTimestamps())
 
 	// Health check
 	fmt.Println("\n" + strings.Repeat("=", 80))
@@ -69,7 +72,7 @@ func addTestData(client *graphiti.Client) bool {
 	fmt.Println("STEP 1: Adding Test Data to Graphiti")
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 
-	now := time.Now().UTC()
+	now := time.Now()
 
 	// Test data: Realistic penetration testing scenario (matches Python version exactly)
 	messages := []graphiti.Message{
@@ -296,18 +299,16 @@ func testTemporalWindowSearch(client *graphiti.Client, observation *graphiti.Obs
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ Searching for activities between 4 and 2 hours ago...")
 
-	now := time.Now().UTC()
+	now := time.Now()
 	timeStart := now.Add(-4 * time.Hour)
 	timeEnd := now.Add(-2 * time.Hour)
 
-	result, err := client.TemporalWindowSearch(graphiti.TemporalSearchRequest{
-		Query:       "vulnerability exploitation attempts",
-		GroupID:     stringPtr(groupID),
-		TimeStart:   timeStart,
-		TimeEnd:     timeEnd,
-		MaxResults:  10,
-		Observation: observation,
-	})
+	result, err := graphiti.NewTemporalSearch("vulnerability exploitation attempts").
+		Group(groupID).
+		Window(timeStart, timeEnd).
+		Max(10).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -339,19 +340,17 @@ func testEntityRelationshipsSearch(client *graphiti.Client, observation *graphit
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ First, finding an entity node UUID from the graph...")
 
-	now := time.Now().UTC()
+	now := time.Now()
 	timeStart := now.Add(-5 * time.Hour)
 	timeEnd := now.Add(-1 * time.Hour)
 
 	// First, do a temporal window search to get edges with node UUIDs
-	tempResult, err := client.TemporalWindowSearch(graphiti.TemporalSearchRequest{
-		Query:       "192.168.1.10 Linux server",
-		GroupID:     stringPtr(groupID),
-		TimeStart:   timeStart,
-		TimeEnd:     timeEnd,
-		MaxResults:  5,
-		Observation: observation,
-	})
+	tempResult, err := graphiti.NewTemporalSearch("192.168.1.10 Linux server").
+		Group(groupID).
+		Window(timeStart, timeEnd).
+		Max(5).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -378,14 +377,13 @@ func testEntityRelationshipsSearch(client *graphiti.Client, observation *graphit
 	fmt.Println("ℹ Searching for relationships around this entity...")
 
 	// Now perform the entity relationships search
-	result, err := client.EntityRelationshipsSearch(graphiti.EntityRelationshipSearchRequest{
-		Query:          "related entities and connections",
-		GroupID:        stringPtr(groupID),
-		CenterNodeUUID: centerNodeUUID,
-		MaxDepth:       2,
-		MaxResults:     20,
-		Observation:    observation,
-	})
+	result, err := graphiti.NewEntityRelationshipsSearch(centerNodeUUID).
+		Query("related entities and connections").
+		Group(groupID).
+		Depth(2).
+		Max(20).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -423,13 +421,12 @@ func testDiverseResultsSearch(client *graphiti.Client, observation *graphiti.Obs
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ Searching for diverse exploitation techniques and vulnerabilities...")
 
-	result, err := client.DiverseResultsSearch(graphiti.DiverseSearchRequest{
-		Query:          "CVE vulnerabilities and exploitation",
-		GroupID:        stringPtr(groupID),
-		DiversityLevel: "medium",
-		MaxResults:     10,
-		Observation:    observation,
-	})
+	result, err := graphiti.NewDiverseSearch("CVE vulnerabilities and exploitation").
+		Group(groupID).
+		Diversity(graphiti.DiversityMedium).
+		Max(10).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -463,12 +460,11 @@ func testEpisodeContextSearch(client *graphiti.Client, observation *graphiti.Obs
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ Searching for full agent responses about Metasploit...")
 
-	result, err := client.EpisodeContextSearch(graphiti.EpisodeContextSearchRequest{
-		Query:       "Metasploit EternalBlue exploitation",
-		GroupID:     stringPtr(groupID),
-		MaxResults:  5,
-		Observation: observation,
-	})
+	result, err := graphiti.NewEpisodeContextSearch("Metasploit EternalBlue exploitation").
+		Group(groupID).
+		Max(5).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -497,13 +493,12 @@ func testSuccessfulToolsSearch(client *graphiti.Client, observation *graphiti.Ob
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ Searching for frequently used successful tools...")
 
-	result, err := client.SuccessfulToolsSearch(graphiti.SuccessfulToolsSearchRequest{
-		Query:       "nmap reconnaissance scanning",
-		GroupID:     stringPtr(groupID),
-		MinMentions: 1,
-		MaxResults:  15,
-		Observation: observation,
-	})
+	result, err := graphiti.NewSuccessfulToolsSearch("nmap reconnaissance scanning").
+		Group(groupID).
+		MinMentions(1).
+		Max(15).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -531,13 +526,12 @@ func testRecentContextSearch(client *graphiti.Client, observation *graphiti.Obse
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ Searching for recent activities in the last 24 hours...")
 
-	result, err := client.RecentContextSearch(graphiti.RecentContextSearchRequest{
-		Query:         "privilege escalation and final summary",
-		GroupID:       stringPtr(groupID),
-		RecencyWindow: "24h",
-		MaxResults:    10,
-		Observation:   observation,
-	})
+	result, err := graphiti.NewRecentContextSearch("privilege escalation and final summary").
+		Group(groupID).
+		Within(24*time.Hour).
+		Max(10).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -564,79 +558,26 @@ func testEntityByLabelSearch(client *graphiti.Client, observation *graphiti.Obse
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 	fmt.Println("ℹ Discovering entity labels in the graph...")
 
-	now := time.Now().UTC()
-	timeStart := now.Add(-6 * time.Hour)
-	timeEnd := now
-
-	// First, use temporal search to get some nodes and discover their labels
-	tempResult, err := client.TemporalWindowSearch(graphiti.TemporalSearchRequest{
-		Query:       "all entities",
-		GroupID:     stringPtr(groupID),
-		TimeStart:   timeStart,
-		TimeEnd:     timeEnd,
-		MaxResults:  15,
-		Observation: observation,
-	})
-
+	searchLabels, err := client.DiscoverLabels(groupID)
 	if err != nil {
-		fmt.Printf("✗ Request failed: %v\n", err)
-		return
-	}
-
-	nodes := tempResult.Nodes
-	if len(nodes) == 0 {
-		fmt.Println("✗ No nodes found to discover labels")
-		return
+		fmt.Printf("ℹ Label discovery failed: %v\n", err)
 	}
-
-	// Collect all unique labels from returned nodes
-	allLabelsMap := make(map[string]bool)
-	for _, node := range nodes {
-		if len(node.Labels) > 0 {
-			for _, label := range node.Labels {
-				allLabelsMap[label] = true
-			}
-		}
-	}
-
-	fmt.Printf("ℹ Found %d nodes in temporal search\n", len(nodes))
-
-	// Prepare labels for entity-by-label search (required field)
-	var searchLabels []string
-	if len(allLabelsMap) > 0 {
-		for label := range allLabelsMap {
-			searchLabels = append(searchLabels, label)
-		}
+	if len(searchLabels) > 0 {
 		fmt.Printf("ℹ Discovered labels: %v\n", searchLabels)
 	} else {
 		// Fallback to Entity label (default in Graphiti)
-		searchLabels = []string{"Entity"}
+		searchLabels = []string{graphiti.LabelEntity}
 		fmt.Println("ℹ No custom labels found, using default \"Entity\" label")
 	}
 
-	// Show sample entities
-	fmt.Println("ℹ Sample entities from graph:")
-	for i, node := range nodes {
-		if i >= 5 {
-			break
-		}
-		labels := node.Labels
-		if len(labels) == 0 {
-			labels = []string{"Entity"}
-		}
-		fmt.Printf("  - name: %s, labels: %v\n", node.Name, labels)
-	}
-
 	// Now perform entity-by-label search with discovered labels
 	fmt.Printf("ℹ Testing entity-by-label search with labels: %v\n", searchLabels)
 
-	result, err := client.EntityByLabelSearch(graphiti.EntityByLabelSearchRequest{
-		Query:       "tools and systems",
-		GroupID:     stringPtr(groupID),
-		NodeLabels:  searchLabels,
-		MaxResults:  15,
-		Observation: observation,
-	})
+	result, err := graphiti.NewEntityByLabelSearch("tools and systems", searchLabels...).
+		Group(groupID).
+		Max(15).
+		Observe(observation).
+		Do(context.Background(), client)
 
 	if err != nil {
 		fmt.Printf("✗ Request failed: %v\n", err)
@@ -663,7 +604,3 @@ func testEntityByLabelSearch(client *graphiti.Client, observation *graphiti.Obse
 		}
 	}
 }
-
-func stringPtr(s string) *string {
-	return &s
-}
@@ -9,3 +9,4 @@ require (
 	github.com/vxcontrol/graphiti-go-client v0.0.0-00010101000000-000000000000
 )
 
+require golang.org/x/time v0.9.0 // indirect
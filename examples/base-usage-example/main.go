@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -98,33 +99,26 @@ func main() {
 	fmt.Println("Waiting for messages to be processed...")
 	maxAttempts := 12
 	pollInterval := 5 * time.Second
-	var episodes []graphiti.Episode
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		fmt.Printf("  Polling for episodes (attempt %d/%d)...\n", attempt, maxAttempts)
-		episodes, err = client.GetEpisodes(groupID, 10)
-		if err != nil {
-			log.Printf("  Warning: Failed to get episodes: %v", err)
-		} else if len(episodes) > 0 {
-			fmt.Printf("  ✓ Found %d episodes, processing complete!\n\n", len(episodes))
-			break
-		}
 
-		if attempt < maxAttempts {
-			time.Sleep(pollInterval)
+	episodes, err := client.WaitForEpisodes(context.Background(), groupID, 1,
+		graphiti.WithPollInterval(pollInterval),
+		graphiti.WithMaxAttempts(maxAttempts))
+	if err != nil {
+		if jobs, jobsErr := client.GetPendingJobs(groupID); jobsErr == nil {
+			for _, job := range jobs {
+				log.Printf("  Pending job %s: status=%s age=%s error=%q", job.ID, job.Status, job.Age().Round(time.Second), job.Error)
+			}
 		}
+		log.Fatalf("Timeout: No episodes were created after %v. The async job may have failed: %v", time.Duration(maxAttempts)*pollInterval, err)
 	}
-
-	if len(episodes) == 0 {
-		log.Fatalf("Timeout: No episodes were created after %v. The async job may have failed.", time.Duration(maxAttempts)*pollInterval)
-	}
+	fmt.Printf("  ✓ Found %d episodes, processing complete!\n\n", len(episodes))
 
 	// Basic Search
 	fmt.Println("=== Basic Search ===")
 	searchResult, err := client.Search(graphiti.SearchQuery{
 		Query:       "What does the user like to do?",
 		MaxFacts:    5,
-		GroupIDs:    &[]string{groupID},
+		GroupIDs:    graphiti.SlicePtr(groupID),
 		Observation: observation,
 	})
 	if err != nil {
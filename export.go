@@ -0,0 +1,172 @@
+package graphiti
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// exportPageSize is the number of items fetched per page during export
+	exportPageSize = 100
+	// importBatchSize is the number of episodes sent per AddMessages request during import
+	importBatchSize = 50
+	// importAuthor is the author of messages recreated from imported episodes
+	// whose content carries no author prefix
+	importAuthor = "graphiti-import"
+)
+
+// Export record types used as the "type" discriminator of NDJSON lines
+const (
+	RecordTypeEpisode = "episode"
+	RecordTypeNode    = "node"
+	RecordTypeEdge    = "edge"
+)
+
+// ExportRecord is a single line of a group export
+type ExportRecord struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// ExportGroup writes all episodes, entity nodes and entity edges of a group to w
+// as newline-delimited JSON, one ExportRecord per line. All collections are
+// fetched page by page. It returns ErrUnsupported if the server cannot page
+// episodes or list the nodes or edges of a group.
+func (c *Client) ExportGroup(ctx context.Context, groupID string, w io.Writer) error {
+	enc := json.NewEncoder(w)
+
+	err := c.eachEpisode(ctx, groupID, func(episode Episode) error {
+		return writeRecord(enc, RecordTypeEpisode, episode)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to export episodes: %w", err)
+	}
+
+	if err := exportPages[EntityNode](ctx, c, enc, RecordTypeNode, groupID, "/entity-nodes/"); err != nil {
+		return fmt.Errorf("failed to export nodes: %w", err)
+	}
+	if err := exportPages[EdgeResult](ctx, c, enc, RecordTypeEdge, groupID, "/entity-edges/"); err != nil {
+		return fmt.Errorf("failed to export edges: %w", err)
+	}
+
+	return nil
+}
+
+// exportPages writes every item of a group's paginated list endpoint under
+// prefix as records
+func exportPages[T any](ctx context.Context, c *Client, enc *json.Encoder, recordType, groupID, prefix string) error {
+	what := recordType + "s of group " + groupID
+	return listGroupPages(ctx, c, what, prefix+url.PathEscape(groupID), func(page []T) error {
+		for _, item := range page {
+			if err := writeRecord(enc, recordType, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func writeRecord(enc *json.Encoder, recordType string, item interface{}) error {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s record: %w", recordType, err)
+	}
+	if err := enc.Encode(ExportRecord{Type: recordType, Data: data}); err != nil {
+		return fmt.Errorf("failed to write %s record: %w", recordType, err)
+	}
+	return nil
+}
+
+// ImportGroup reads records written by ExportGroup from r into the group on
+// this client's server. Episodes are re-ingested as messages with their
+// original source, so the server rebuilds their entities and facts; the author
+// is recovered from the "author: " prefix the server adds to episode content
+// and the prefix is stripped, so it is not doubled. Entity nodes are upserted.
+// Edge records are skipped since edges are derived from the ingested episodes.
+func (c *Client) ImportGroup(ctx context.Context, groupID string, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	batch := make([]Message, 0, importBatchSize)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		request := AddMessagesRequest{GroupID: groupID, Messages: batch}
+		if err := c.doContext(ctx, http.MethodPost, "/messages", request, nil); err != nil {
+			return fmt.Errorf("failed to import episodes: %w", err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		var record ExportRecord
+		if err := dec.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("failed to read record: %w", err)
+		}
+
+		switch record.Type {
+		case RecordTypeEpisode:
+			var episode Episode
+			if err := json.Unmarshal(record.Data, &episode); err != nil {
+				return fmt.Errorf("failed to decode episode record: %w", err)
+			}
+			author, content := splitEpisodeAuthor(episode.Content)
+			batch = append(batch, Message{
+				Content:           content,
+				Name:              episode.Name,
+				Author:            author,
+				Timestamp:         episode.ValidAt,
+				Source:            episode.Source,
+				SourceDescription: episode.SourceDescription,
+			})
+			if len(batch) == importBatchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case RecordTypeNode:
+			var node EntityNode
+			if err := json.Unmarshal(record.Data, &node); err != nil {
+				return fmt.Errorf("failed to decode node record: %w", err)
+			}
+			request := AddEntityNodeRequest{
+				UUID:     node.UUID,
+				GroupID:  groupID,
+				Name:     node.Name,
+				Summary:  node.Summary,
+				Labels:   node.Labels,
+				Metadata: node.Metadata,
+			}
+			if err := c.doContext(ctx, http.MethodPost, "/entity-node", request, nil); err != nil {
+				return fmt.Errorf("failed to import node %s: %w", node.UUID, err)
+			}
+		case RecordTypeEdge:
+			// edges are rebuilt by the server from the ingested episodes
+		default:
+			return fmt.Errorf("unknown record type %q", record.Type)
+		}
+	}
+
+	return flush()
+}
+
+// splitEpisodeAuthor splits episode content into the author the server
+// prefixed it with and the original content. Content without a plausible
+// "author: " prefix is attributed to importAuthor and returned unchanged.
+func splitEpisodeAuthor(content string) (string, string) {
+	author, rest, ok := strings.Cut(content, ": ")
+	if !ok || author == "" || strings.ContainsAny(author, "\n\"{}[]") {
+		return importAuthor, content
+	}
+	return author, rest
+}
@@ -0,0 +1,57 @@
+package graphiti_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestExportImportRoundTrip(t *testing.T) {
+	source := graphititest.NewMockServer()
+	defer source.Close()
+	target := graphititest.NewMockServer()
+	defer target.Close()
+
+	ctx := context.Background()
+	timestamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	messages := []graphiti.Message{
+		{Content: "nmap found port 22 open", Name: "scan", Author: "alice", Timestamp: timestamp},
+		{Content: `{"host": "10.0.0.1", "ports": [22]}`, Name: "result", Author: "scanner", Timestamp: timestamp, Source: graphiti.EpisodeSourceJSON},
+		{Content: "plain notes", Name: "notes", Author: "bob", Timestamp: timestamp, Source: graphiti.EpisodeSourceText},
+	}
+	if _, err := source.Client().AddMessages(graphiti.AddMessagesRequest{GroupID: "g", Messages: messages}); err != nil {
+		t.Fatalf("AddMessages: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := source.Client().ExportGroup(ctx, "g", &buf); err != nil {
+		t.Fatalf("ExportGroup: %v", err)
+	}
+	if err := target.Client().ImportGroup(ctx, "g", &buf); err != nil {
+		t.Fatalf("ImportGroup: %v", err)
+	}
+
+	want := source.Episodes("g")
+	got := target.Episodes("g")
+	if len(got) != len(want) {
+		t.Fatalf("imported %d episodes, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Content != want[i].Content {
+			t.Errorf("episode %d content = %q, want %q", i, got[i].Content, want[i].Content)
+		}
+		if got[i].Source != want[i].Source {
+			t.Errorf("episode %d source = %q, want %q", i, got[i].Source, want[i].Source)
+		}
+		if got[i].Name != want[i].Name {
+			t.Errorf("episode %d name = %q, want %q", i, got[i].Name, want[i].Name)
+		}
+		if !got[i].ValidAt.Equal(want[i].ValidAt) {
+			t.Errorf("episode %d valid at = %s, want %s", i, got[i].ValidAt, want[i].ValidAt)
+		}
+	}
+}
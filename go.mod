@@ -1,3 +1,5 @@
 module github.com/vxcontrol/graphiti-go-client
 
 go 1.23
+
+require golang.org/x/time v0.9.0
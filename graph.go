@@ -0,0 +1,140 @@
+package graphiti
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// GraphExport is the subgraph of a single group
+type GraphExport struct {
+	GroupID     string            `json:"group_id"`
+	Nodes       []NodeResult      `json:"nodes"`
+	Edges       []EdgeResult      `json:"edges"`
+	Episodes    []EpisodeResult   `json:"episodes"`
+	Communities []CommunityResult `json:"communities"`
+}
+
+// ExportGraph assembles the subgraph of a group by paging through its
+// episodes, entity nodes, entity edges and communities. It returns
+// ErrUnsupported if the server cannot page episodes or list the nodes or
+// edges of a group; communities are left empty on servers without them.
+// Unlike ExportGroup, which streams records for ImportGroup, the result is
+// held in memory for analysis.
+func (c *Client) ExportGraph(ctx context.Context, groupID string) (*GraphExport, error) {
+	export := &GraphExport{
+		GroupID:     groupID,
+		Nodes:       []NodeResult{},
+		Edges:       []EdgeResult{},
+		Episodes:    []EpisodeResult{},
+		Communities: []CommunityResult{},
+	}
+
+	err := c.eachEpisode(ctx, groupID, func(episode Episode) error {
+		export.Episodes = append(export.Episodes, EpisodeResult{
+			UUID:              episode.UUID,
+			Content:           episode.Content,
+			Source:            episode.Source,
+			SourceDescription: episode.SourceDescription,
+			CreatedAt:         episode.CreatedAt,
+			ValidAt:           episode.ValidAt,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export episodes: %w", err)
+	}
+
+	err = listGroupPages(ctx, c, "nodes of group "+groupID, "/entity-nodes/"+url.PathEscape(groupID), func(page []EntityNode) error {
+		for _, node := range page {
+			export.Nodes = append(export.Nodes, NodeResult{
+				UUID:       node.UUID,
+				Name:       node.Name,
+				Labels:     node.Labels,
+				Summary:    node.Summary,
+				CreatedAt:  node.CreatedAt,
+				Attributes: node.Metadata,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export nodes: %w", err)
+	}
+
+	err = listGroupPages(ctx, c, "edges of group "+groupID, "/entity-edges/"+url.PathEscape(groupID), func(page []EdgeResult) error {
+		export.Edges = append(export.Edges, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export edges: %w", err)
+	}
+
+	var communities []CommunityResult
+	path := fmt.Sprintf("/communities/%s", url.PathEscape(groupID))
+	if err := c.doContext(ctx, http.MethodGet, path, nil, &communities); err != nil && !isMissing(err) {
+		return nil, fmt.Errorf("failed to export communities: %w", err)
+	}
+	export.Communities = append(export.Communities, communities...)
+
+	return export, nil
+}
+
+// EnrichFacts converts facts to edges with their source and target node UUIDs,
+// which a bare fact lacks, by listing the entity edges of the group the facts
+// were found in. The edges are returned in the order of facts. Facts whose
+// edge is not in the group are converted with AsEdge and reported with
+// ErrEdgeNotFound in a *PartialError. It returns ErrUnsupported if the server
+// cannot list the edges of a group.
+func (c *Client) EnrichFacts(ctx context.Context, groupID string, facts []FactResult) ([]EdgeResult, error) {
+	if len(facts) == 0 {
+		return []EdgeResult{}, nil
+	}
+
+	wanted := make(map[string]*EdgeResult, len(facts))
+	for _, fact := range facts {
+		wanted[fact.UUID] = nil
+	}
+	err := listGroupPages(ctx, c, "edges of group "+groupID, "/entity-edges/"+url.PathEscape(groupID), func(page []EdgeResult) error {
+		for i := range page {
+			if _, ok := wanted[page[i].UUID]; ok {
+				wanted[page[i].UUID] = &page[i]
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]EdgeResult, len(facts))
+	failures := make(map[string]error)
+	for i, fact := range facts {
+		if edge := wanted[fact.UUID]; edge != nil {
+			edges[i] = *edge
+			continue
+		}
+		edges[i] = fact.AsEdge()
+		failures[fact.UUID] = fmt.Errorf("edge %s in group %s: %w", fact.UUID, groupID, ErrEdgeNotFound)
+	}
+	if len(failures) > 0 {
+		return edges, &PartialError{Failures: failures}
+	}
+	return edges, nil
+}
+
+// ExportGraphJSON exports the subgraph of a group with ExportGraph and writes
+// it to w as a single JSON document
+func (c *Client) ExportGraphJSON(ctx context.Context, groupID string, w io.Writer) error {
+	export, err := c.ExportGraph(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(export); err != nil {
+		return fmt.Errorf("failed to write graph export: %w", err)
+	}
+	return nil
+}
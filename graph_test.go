@@ -0,0 +1,56 @@
+package graphiti_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestExportGraphPagesEpisodes(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	// more than one page of episodes
+	for i := 0; i < 250; i++ {
+		server.AddEpisodes(graphititest.NewEpisode(fmt.Sprintf("e%d", i), "g", "content", time.Now().UTC()))
+	}
+	server.AddEntityNodes(graphititest.NewEntityNode("n1", "g", "host"))
+
+	export, err := server.Client().ExportGraph(context.Background(), "g")
+	if err != nil {
+		t.Fatalf("ExportGraph: %v", err)
+	}
+	if len(export.Episodes) != 250 {
+		t.Errorf("exported %d episodes, want 250", len(export.Episodes))
+	}
+	if len(export.Nodes) != 1 {
+		t.Errorf("exported %d nodes, want 1", len(export.Nodes))
+	}
+}
+
+func TestExportGraphUnsupported(t *testing.T) {
+	routes := []string{
+		graphititest.RouteGetEpisodesPage,
+		graphititest.RouteListEntityNodes,
+		graphititest.RouteListEntityEdges,
+	}
+
+	for _, route := range routes {
+		t.Run(route, func(t *testing.T) {
+			server := graphititest.NewMockServer()
+			defer server.Close()
+			server.Respond(route, http.StatusNotFound, map[string]string{"detail": "Not Found"})
+
+			_, err := server.Client().ExportGraph(context.Background(), "g")
+			if !errors.Is(err, graphiti.ErrUnsupported) {
+				t.Errorf("ExportGraph error = %v, want ErrUnsupported", err)
+			}
+		})
+	}
+}
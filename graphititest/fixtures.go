@@ -0,0 +1,79 @@
+package graphititest
+
+import (
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+// AddFacts seeds facts returned by Search and GetMemory and served by GetEntityEdge
+func (s *MockServer) AddFacts(facts ...graphiti.FactResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.facts = append(s.facts, facts...)
+}
+
+// AddEpisodes seeds episodes into their groups, as if they had been ingested
+func (s *MockServer) AddEpisodes(episodes ...graphiti.Episode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, episode := range episodes {
+		s.episodes[episode.GroupID] = append(s.episodes[episode.GroupID], episode)
+	}
+}
+
+// AddEntityNodes seeds entity nodes
+func (s *MockServer) AddEntityNodes(nodes ...graphiti.EntityNode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, node := range nodes {
+		s.nodes[node.UUID] = node
+	}
+}
+
+// Episodes returns the episodes currently stored for a group
+func (s *MockServer) Episodes(groupID string) []graphiti.Episode {
+	return s.groupEpisodes(groupID)
+}
+
+// EntityNode returns a stored entity node and whether it exists
+func (s *MockServer) EntityNode(uuid string) (graphiti.EntityNode, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	node, ok := s.nodes[uuid]
+	return node, ok
+}
+
+// NewFact returns a fact fixture created now
+func NewFact(uuid, fact string) graphiti.FactResult {
+	return graphiti.FactResult{
+		UUID:      uuid,
+		Name:      "RELATES_TO",
+		Fact:      fact,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// NewEpisode returns a message episode fixture valid at validAt
+func NewEpisode(uuid, groupID, content string, validAt time.Time) graphiti.Episode {
+	return graphiti.Episode{
+		UUID:      uuid,
+		GroupID:   groupID,
+		Name:      uuid,
+		Content:   content,
+		Source:    graphiti.EpisodeSourceMessage,
+		CreatedAt: time.Now().UTC(),
+		ValidAt:   validAt,
+	}
+}
+
+// NewEntityNode returns an entity node fixture created now
+func NewEntityNode(uuid, groupID, name string) graphiti.EntityNode {
+	return graphiti.EntityNode{
+		UUID:      uuid,
+		GroupID:   groupID,
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+		Labels:    []string{"Entity"},
+	}
+}
@@ -0,0 +1,593 @@
+package graphititest
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+// routes registers the default in-memory handlers
+func (s *MockServer) routes() {
+	s.handle(RouteHealthCheck, s.handleHealthCheck)
+	s.handle(RouteServerInfo, s.handleServerInfo)
+	s.handle(RouteSearch, s.handleSearch)
+	s.handle(RouteGetMemory, s.handleGetMemory)
+	s.handle(RouteAddMessages, s.handleAddMessages)
+	s.handle(RouteGetJobStatus, s.handleGetJobStatus)
+	s.handle(RouteGetEpisodes, s.handleGetEpisodes)
+	s.handle(RouteGetEpisodesPage, s.handleGetEpisodesPage)
+	s.handle(RouteEpisodeExists, s.handleEpisodeExists)
+	s.handle(RouteDeleteEpisode, s.handleDeleteEpisode)
+	s.handle(RouteAddEntityNode, s.handleAddEntityNode)
+	s.handle(RouteGetEntityNode, s.handleGetEntityNode)
+	s.handle(RouteUpdateEntityNode, s.handleUpdateEntityNode)
+	s.handle(RouteMergeEntityNodes, s.handleMergeEntityNodes)
+	s.handle(RouteAddEntityEdge, s.handleAddEntityEdge)
+	s.handle(RouteGetEntityEdge, s.handleGetEntityEdge)
+	s.handle(RouteEntityEdgeExists, s.handleGetEntityEdge)
+	s.handle(RouteDeleteEntityEdge, s.handleDeleteEntityEdge)
+	s.handle(RouteListGroups, s.handleListGroups)
+	s.handle(RouteGroupExists, s.handleGroupExists)
+	s.handle(RouteDeleteGroup, s.handleDeleteGroup)
+	s.handle(RouteClear, s.handleClear)
+	s.handle(RouteListEntityNodes, s.handleListEntityNodes)
+	s.handle(RouteListEntityEdges, s.handleListEntityEdges)
+
+	s.handle(RouteTemporalWindow, s.handleTemporalWindow)
+	s.handle(RouteEntityRelationships, emptyResponse[graphiti.EntityRelationshipSearchResponse])
+	s.handle(RouteDiverseResults, emptyResponse[graphiti.DiverseSearchResponse])
+	s.handle(RouteEpisodeContext, emptyResponse[graphiti.EpisodeContextSearchResponse])
+	s.handle(RouteSuccessfulTools, emptyResponse[graphiti.SuccessfulToolsSearchResponse])
+	s.handle(RouteRecentContext, emptyResponse[graphiti.RecentContextSearchResponse])
+	s.handle(RouteEntityByLabel, emptyResponse[graphiti.EntityByLabelSearchResponse])
+	s.handle(RouteCommunitySearch, emptyResponse[graphiti.CommunitySearchResponse])
+	s.handle(RouteGetCommunities, emptyResponse[[]graphiti.CommunityResult])
+}
+
+func (s *MockServer) handleHealthCheck(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, graphiti.HealthCheckResponse{Status: "healthy"})
+}
+
+func (s *MockServer) handleServerInfo(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, graphiti.ServerInfo{
+		Version: Version,
+		Features: []string{
+			graphiti.FeatureAdvancedSearch,
+			graphiti.FeatureCommunities,
+			graphiti.FeatureMergeNodes,
+		},
+	})
+}
+
+func (s *MockServer) handleSearch(w http.ResponseWriter, r *http.Request) {
+	var query graphiti.SearchQuery
+	if !decode(w, r, &query) {
+		return
+	}
+	writeJSON(w, http.StatusOK, graphiti.SearchResults{Facts: s.limitFacts(query.MaxFacts)})
+}
+
+func (s *MockServer) handleGetMemory(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.GetMemoryRequest
+	if !decode(w, r, &request) {
+		return
+	}
+	writeJSON(w, http.StatusOK, graphiti.GetMemoryResponse{Facts: s.limitFacts(request.MaxFacts)})
+}
+
+// limitFacts returns at most maxFacts seeded facts, or all of them if maxFacts is not positive
+func (s *MockServer) limitFacts(maxFacts int) []graphiti.FactResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	facts := s.facts
+	if maxFacts > 0 && len(facts) > maxFacts {
+		facts = facts[:maxFacts]
+	}
+	return append([]graphiti.FactResult{}, facts...)
+}
+
+func (s *MockServer) handleAddMessages(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.AddMessagesRequest
+	if !decode(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	now := time.Now().UTC()
+	for _, message := range request.Messages {
+		uuid := s.newID("episode")
+		if message.UUID != nil {
+			uuid = *message.UUID
+		}
+		source := message.Source
+		if source == "" {
+			source = graphiti.EpisodeSourceMessage
+		}
+		s.episodes[request.GroupID] = append(s.episodes[request.GroupID], graphiti.Episode{
+			UUID:              uuid,
+			GroupID:           request.GroupID,
+			Name:              message.Name,
+			Content:           message.Author + ": " + message.Content,
+			Source:            source,
+			SourceDescription: message.SourceDescription,
+			CreatedAt:         now,
+			ValidAt:           message.Timestamp,
+		})
+	}
+	jobID := s.newID("job")
+	s.jobs[jobID] = graphiti.JobStatus{
+		ID:        jobID,
+		GroupID:   request.GroupID,
+		Status:    graphiti.JobStatusCompleted,
+		Attempts:  1,
+		CreatedAt: now,
+		StartedAt: &now,
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusAccepted, graphiti.AddMessagesResponse{
+		Result: graphiti.Result{Message: "Messages added to processing queue", Success: true},
+		JobID:  jobID,
+	})
+}
+
+func (s *MockServer) handleGetJobStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	job, ok := s.jobs[r.PathValue("job_id")]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "job not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (s *MockServer) handleGetEpisodes(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	since, ok := parseTimeParam(w, query.Get("since"))
+	if !ok {
+		return
+	}
+	until, ok := parseTimeParam(w, query.Get("until"))
+	if !ok {
+		return
+	}
+
+	var episodes []graphiti.Episode
+	for _, episode := range s.groupEpisodes(r.PathValue("group_id")) {
+		if !since.IsZero() && episode.ValidAt.Before(since) {
+			continue
+		}
+		if !until.IsZero() && episode.ValidAt.After(until) {
+			continue
+		}
+		if name := query.Get("name"); name != "" && episode.Name != name {
+			continue
+		}
+		if author := query.Get("author"); author != "" && !strings.HasPrefix(episode.Content, author+": ") {
+			continue
+		}
+		episodes = append(episodes, episode)
+	}
+
+	if lastN, err := strconv.Atoi(query.Get("last_n")); err == nil && lastN >= 0 && len(episodes) > lastN {
+		episodes = episodes[len(episodes)-lastN:]
+	}
+	if episodes == nil {
+		episodes = []graphiti.Episode{}
+	}
+	writeJSON(w, http.StatusOK, episodes)
+}
+
+func (s *MockServer) handleGetEpisodesPage(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	episodes := s.groupEpisodes(r.PathValue("group_id"))
+
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	if cursor := query.Get("cursor"); cursor != "" {
+		offset, _ = strconv.Atoi(cursor)
+	}
+	offset = min(max(offset, 0), len(episodes))
+
+	end := len(episodes)
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		end = min(offset+limit, len(episodes))
+	}
+
+	page := graphiti.EpisodePage{
+		Episodes: append([]graphiti.Episode{}, episodes[offset:end]...),
+		HasMore:  end < len(episodes),
+	}
+	if page.HasMore {
+		page.NextCursor = strconv.Itoa(end)
+	}
+	writeJSON(w, http.StatusOK, page)
+}
+
+// groupEpisodes returns a copy of the group's episodes in insertion order
+func (s *MockServer) groupEpisodes(groupID string) []graphiti.Episode {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]graphiti.Episode{}, s.episodes[groupID]...)
+}
+
+func (s *MockServer) handleEpisodeExists(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, episodes := range s.episodes {
+		for _, episode := range episodes {
+			if episode.UUID == uuid {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (s *MockServer) handleDeleteEpisode(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for groupID, episodes := range s.episodes {
+		for i, episode := range episodes {
+			if episode.UUID == uuid {
+				s.episodes[groupID] = append(episodes[:i:i], episodes[i+1:]...)
+				writeJSON(w, http.StatusOK, graphiti.Result{Message: "Episode deleted", Success: true})
+				return
+			}
+		}
+	}
+	writeError(w, http.StatusNotFound, "episode not found")
+}
+
+func (s *MockServer) handleAddEntityNode(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.AddEntityNodeRequest
+	if !decode(w, r, &request) {
+		return
+	}
+
+	node := graphiti.EntityNode{
+		UUID:      request.UUID,
+		GroupID:   request.GroupID,
+		Name:      request.Name,
+		Summary:   request.Summary,
+		CreatedAt: time.Now().UTC(),
+		Labels:    request.Labels,
+		Metadata:  request.Metadata,
+	}
+
+	s.mu.Lock()
+	if existing, ok := s.nodes[node.UUID]; ok {
+		node.CreatedAt = existing.CreatedAt
+	}
+	s.nodes[node.UUID] = node
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, node)
+}
+
+func (s *MockServer) handleGetEntityNode(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	node, ok := s.nodes[r.PathValue("uuid")]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "entity node not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, node)
+}
+
+func (s *MockServer) handleUpdateEntityNode(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.UpdateEntityNodeRequest
+	if !decode(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	node, ok := s.nodes[r.PathValue("uuid")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "entity node not found")
+		return
+	}
+	if request.Name != "" {
+		node.Name = request.Name
+	}
+	if request.Summary != "" {
+		node.Summary = request.Summary
+	}
+	if request.Labels != nil {
+		node.Labels = request.Labels
+	}
+	if request.Metadata != nil {
+		node.Metadata = request.Metadata
+	}
+	s.nodes[node.UUID] = node
+
+	writeJSON(w, http.StatusOK, node)
+}
+
+func (s *MockServer) handleMergeEntityNodes(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.MergeNodesRequest
+	if !decode(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	primary, ok := s.nodes[request.PrimaryUUID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "entity node not found")
+		return
+	}
+	duplicates := make(map[string]bool, len(request.DuplicateUUIDs))
+	for _, uuid := range request.DuplicateUUIDs {
+		if _, ok := s.nodes[uuid]; !ok {
+			writeError(w, http.StatusNotFound, "entity node not found")
+			return
+		}
+		duplicates[uuid] = true
+	}
+
+	for uuid := range duplicates {
+		delete(s.nodes, uuid)
+	}
+	for uuid, edge := range s.edges {
+		if duplicates[edge.SourceNodeUUID] {
+			edge.SourceNodeUUID = primary.UUID
+		}
+		if duplicates[edge.TargetNodeUUID] {
+			edge.TargetNodeUUID = primary.UUID
+		}
+		s.edges[uuid] = edge
+	}
+
+	writeJSON(w, http.StatusOK, primary)
+}
+
+func (s *MockServer) handleAddEntityEdge(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.AddEntityEdgeRequest
+	if !decode(w, r, &request) {
+		return
+	}
+
+	edge := graphiti.EdgeResult{
+		UUID:           request.UUID,
+		Fact:           request.Fact,
+		SourceNodeUUID: request.SourceNodeUUID,
+		TargetNodeUUID: request.TargetNodeUUID,
+		ValidAt:        request.ValidAt,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	s.mu.Lock()
+	s.edges[edge.UUID] = edge
+	s.edgeGroup[edge.UUID] = request.GroupID
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, edge)
+}
+
+func (s *MockServer) handleGetEntityEdge(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if edge, ok := s.edges[uuid]; ok {
+		writeJSON(w, http.StatusOK, graphiti.FactResult{
+			UUID:      edge.UUID,
+			Name:      edge.Name,
+			Fact:      edge.Fact,
+			ValidAt:   edge.ValidAt,
+			InvalidAt: edge.InvalidAt,
+			CreatedAt: edge.CreatedAt,
+			ExpiredAt: edge.ExpiredAt,
+		})
+		return
+	}
+	for _, fact := range s.facts {
+		if fact.UUID == uuid {
+			writeJSON(w, http.StatusOK, fact)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "entity edge not found")
+}
+
+func (s *MockServer) handleDeleteEntityEdge(w http.ResponseWriter, r *http.Request) {
+	uuid := r.PathValue("uuid")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.edges[uuid]; !ok {
+		writeError(w, http.StatusNotFound, "entity edge not found")
+		return
+	}
+	delete(s.edges, uuid)
+	delete(s.edgeGroup, uuid)
+	writeJSON(w, http.StatusOK, graphiti.Result{Message: "Entity edge deleted", Success: true})
+}
+
+func (s *MockServer) handleListEntityNodes(w http.ResponseWriter, r *http.Request) {
+	groupID := r.PathValue("group_id")
+
+	s.mu.Lock()
+	var nodes []graphiti.EntityNode
+	for _, node := range s.nodes {
+		if node.GroupID == groupID {
+			nodes = append(nodes, node)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].UUID < nodes[j].UUID })
+	writeJSON(w, http.StatusOK, paginate(r, nodes))
+}
+
+func (s *MockServer) handleListEntityEdges(w http.ResponseWriter, r *http.Request) {
+	groupID := r.PathValue("group_id")
+
+	s.mu.Lock()
+	var edges []graphiti.EdgeResult
+	for uuid, edgeGroupID := range s.edgeGroup {
+		if edgeGroupID == groupID {
+			edges = append(edges, s.edges[uuid])
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(edges, func(i, j int) bool { return edges[i].UUID < edges[j].UUID })
+	writeJSON(w, http.StatusOK, paginate(r, edges))
+}
+
+func (s *MockServer) handleListGroups(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	groups := make(map[string]*graphiti.GroupInfo)
+	group := func(groupID string) *graphiti.GroupInfo {
+		if groups[groupID] == nil {
+			groups[groupID] = &graphiti.GroupInfo{GroupID: groupID}
+		}
+		return groups[groupID]
+	}
+	for groupID, episodes := range s.episodes {
+		if len(episodes) > 0 {
+			group(groupID).EpisodeCount = len(episodes)
+		}
+	}
+	for _, node := range s.nodes {
+		group(node.GroupID).NodeCount++
+	}
+	for _, groupID := range s.edgeGroup {
+		group(groupID).EdgeCount++
+	}
+	s.mu.Unlock()
+
+	result := make([]graphiti.GroupInfo, 0, len(groups))
+	for _, info := range groups {
+		result = append(result, *info)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].GroupID < result[j].GroupID })
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *MockServer) handleGroupExists(w http.ResponseWriter, r *http.Request) {
+	if !s.hasGroup(r.PathValue("group_id")) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// hasGroup reports whether any episode, node or edge belongs to the group
+func (s *MockServer) hasGroup(groupID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.episodes[groupID]) > 0 {
+		return true
+	}
+	for _, node := range s.nodes {
+		if node.GroupID == groupID {
+			return true
+		}
+	}
+	for _, edgeGroupID := range s.edgeGroup {
+		if edgeGroupID == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MockServer) handleDeleteGroup(w http.ResponseWriter, r *http.Request) {
+	groupID := r.PathValue("group_id")
+
+	s.mu.Lock()
+	delete(s.episodes, groupID)
+	for uuid, node := range s.nodes {
+		if node.GroupID == groupID {
+			delete(s.nodes, uuid)
+		}
+	}
+	for uuid, edgeGroupID := range s.edgeGroup {
+		if edgeGroupID == groupID {
+			delete(s.edges, uuid)
+			delete(s.edgeGroup, uuid)
+		}
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, graphiti.Result{Message: "Group deleted", Success: true})
+}
+
+func (s *MockServer) handleClear(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	s.episodes = make(map[string][]graphiti.Episode)
+	s.nodes = make(map[string]graphiti.EntityNode)
+	s.edges = make(map[string]graphiti.EdgeResult)
+	s.edgeGroup = make(map[string]string)
+	s.facts = nil
+	s.jobs = make(map[string]graphiti.JobStatus)
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, graphiti.Result{Message: "Graph cleared", Success: true})
+}
+
+func (s *MockServer) handleTemporalWindow(w http.ResponseWriter, r *http.Request) {
+	var request graphiti.TemporalSearchRequest
+	if !decode(w, r, &request) {
+		return
+	}
+	writeJSON(w, http.StatusOK, graphiti.TemporalSearchResponse{
+		TimeWindow: graphiti.TimeWindow{Start: request.TimeStart, End: request.TimeEnd},
+	})
+}
+
+// paginate applies the limit and offset query parameters to items
+func paginate[T any](r *http.Request, items []T) []T {
+	query := r.URL.Query()
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	offset = min(max(offset, 0), len(items))
+
+	end := len(items)
+	if limit, err := strconv.Atoi(query.Get("limit")); err == nil && limit > 0 {
+		end = min(offset+limit, len(items))
+	}
+	return append([]T{}, items[offset:end]...)
+}
+
+// emptyResponse replies with an empty search response of type T
+func emptyResponse[T any](w http.ResponseWriter, _ *http.Request) {
+	var response T
+	writeJSON(w, http.StatusOK, response)
+}
+
+// parseTimeParam parses an optional RFC 3339 query parameter, replying 422 on failure
+func parseTimeParam(w http.ResponseWriter, value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, true
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "invalid time "+strconv.Quote(value))
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,191 @@
+// Package graphititest provides an in-memory mock of the Graphiti API for
+// testing code that uses the graphiti client without a running server.
+package graphititest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+// Version is the server version reported by MockServer
+const Version = "graphititest"
+
+// Route patterns served by MockServer, usable with Handle and Respond
+const (
+	RouteHealthCheck         = "GET /healthcheck"
+	RouteServerInfo          = "GET /info"
+	RouteSearch              = "POST /search"
+	RouteGetMemory           = "POST /get-memory"
+	RouteAddMessages         = "POST /messages"
+	RouteGetJobStatus        = "GET /job/{job_id}"
+	RouteGetEpisodes         = "GET /episodes/{group_id}"
+	RouteGetEpisodesPage     = "GET /episodes/{group_id}/page"
+	RouteEpisodeExists       = "HEAD /episode/{uuid}"
+	RouteDeleteEpisode       = "DELETE /episode/{uuid}"
+	RouteAddEntityNode       = "POST /entity-node"
+	RouteGetEntityNode       = "GET /entity-node/{uuid}"
+	RouteUpdateEntityNode    = "PATCH /entity-node/{uuid}"
+	RouteMergeEntityNodes    = "POST /entity-node/merge"
+	RouteAddEntityEdge       = "POST /entity-edge"
+	RouteGetEntityEdge       = "GET /entity-edge/{uuid}"
+	RouteEntityEdgeExists    = "HEAD /entity-edge/{uuid}"
+	RouteDeleteEntityEdge    = "DELETE /entity-edge/{uuid}"
+	RouteListGroups          = "GET /groups"
+	RouteGroupExists         = "HEAD /group/{group_id}"
+	RouteDeleteGroup         = "DELETE /group/{group_id}"
+	RouteClear               = "POST /clear"
+	RouteTemporalWindow      = "POST /search/temporal-window"
+	RouteEntityRelationships = "POST /search/entity-relationships"
+	RouteDiverseResults      = "POST /search/diverse-results"
+	RouteEpisodeContext      = "POST /search/episode-context"
+	RouteSuccessfulTools     = "POST /search/successful-tools"
+	RouteRecentContext       = "POST /search/recent-context"
+	RouteEntityByLabel       = "POST /search/entity-by-label"
+	RouteCommunitySearch     = "POST /search/communities"
+	RouteGetCommunities      = "GET /communities/{group_id}"
+	RouteListEntityNodes     = "GET /entity-nodes/{group_id}"
+	RouteListEntityEdges     = "GET /entity-edges/{group_id}"
+)
+
+// MockServer is an httptest.Server implementing the Graphiti API in memory.
+// Messages become episodes immediately, entity nodes and edges are stored as
+// sent, ingestion jobs complete as soon as they are created, and searches
+// return the facts seeded with AddFacts. The advanced search routes return
+// empty results unless overridden with Respond.
+type MockServer struct {
+	*httptest.Server
+
+	mux *http.ServeMux
+
+	mu        sync.Mutex
+	delay     time.Duration
+	overrides map[string]http.HandlerFunc
+	episodes  map[string][]graphiti.Episode
+	nodes     map[string]graphiti.EntityNode
+	edges     map[string]graphiti.EdgeResult
+	edgeGroup map[string]string
+	facts     []graphiti.FactResult
+	jobs      map[string]graphiti.JobStatus
+	nextID    int
+}
+
+// NewMockServer starts a new mock server. Callers should Close it when done.
+func NewMockServer() *MockServer {
+	s := &MockServer{
+		mux:       http.NewServeMux(),
+		overrides: make(map[string]http.HandlerFunc),
+		episodes:  make(map[string][]graphiti.Episode),
+		nodes:     make(map[string]graphiti.EntityNode),
+		edges:     make(map[string]graphiti.EdgeResult),
+		edgeGroup: make(map[string]string),
+		jobs:      make(map[string]graphiti.JobStatus),
+	}
+	s.routes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns a graphiti client pointed at the mock server
+func (s *MockServer) Client(opts ...graphiti.ClientOption) *graphiti.Client {
+	opts = append([]graphiti.ClientOption{graphiti.WithHTTPClient(s.Server.Client())}, opts...)
+	return graphiti.NewClient(s.URL, opts...)
+}
+
+// Handle replaces the handler of a route, e.g. RouteSearch. The handler can
+// read path parameters with r.PathValue. Patterns that are not served by the
+// mock are ignored.
+func (s *MockServer) Handle(route string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[route] = handler
+}
+
+// Respond makes a route reply with the given status code and JSON body,
+// e.g. Respond(RouteSearch, http.StatusServiceUnavailable, map[string]string{"detail": "down"})
+func (s *MockServer) Respond(route string, statusCode int, body interface{}) {
+	s.Handle(route, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, statusCode, body)
+	})
+}
+
+// Reset removes the handler overrides set with Handle and Respond
+func (s *MockServer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides = make(map[string]http.HandlerFunc)
+}
+
+// SetDelay delays every response by d, or until the request is canceled
+func (s *MockServer) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay = d
+}
+
+// serveHTTP applies the configured delay before routing
+func (s *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	delay := s.delay
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers the default handler of a route, dispatching to its
+// override when one is set. Overrides run after routing, so r.PathValue works.
+func (s *MockServer) handle(route string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(route, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		override := s.overrides[route]
+		s.mu.Unlock()
+
+		if override != nil {
+			override(w, r)
+			return
+		}
+		handler(w, r)
+	})
+}
+
+// newID returns a unique identifier for server-generated objects
+func (s *MockServer) newID(prefix string) string {
+	s.nextID++
+	return prefix + "-" + strconv.Itoa(s.nextID)
+}
+
+// writeJSON writes v as a JSON response with the given status code
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+// writeError writes a JSON error response in the server's {"detail": ...} form
+func writeError(w http.ResponseWriter, statusCode int, detail string) {
+	writeJSON(w, statusCode, map[string]string{"detail": detail})
+}
+
+// decode reads the JSON request body into v, replying 422 on failure
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return false
+	}
+	return true
+}
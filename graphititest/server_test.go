@@ -0,0 +1,72 @@
+package graphititest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+func TestMockServerRoundTrip(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client := server.Client()
+
+	_, err := client.AddMessages(graphiti.AddMessagesRequest{
+		GroupID:  "g",
+		Messages: []graphiti.Message{{Content: "hello", Name: "greeting", Author: "alice", Timestamp: time.Now().UTC()}},
+	})
+	if err != nil {
+		t.Fatalf("AddMessages: %v", err)
+	}
+
+	episodes, err := client.GetEpisodes("g", 10)
+	if err != nil {
+		t.Fatalf("GetEpisodes: %v", err)
+	}
+	if len(episodes) != 1 || episodes[0].Content != "alice: hello" {
+		t.Errorf("episodes = %+v, want the ingested message", episodes)
+	}
+}
+
+func TestMockServerSearchReturnsSeededFacts(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.AddFacts(NewFact("f1", "port 22 is open"), NewFact("f2", "ssh is OpenSSH 7.4"))
+
+	results, err := server.Client().Search(graphiti.SearchQuery{Query: "ssh", MaxFacts: 1})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results.Facts) != 1 || results.Facts[0].UUID != "f1" {
+		t.Errorf("facts = %+v, want only f1", results.Facts)
+	}
+}
+
+func TestMockServerRespondAndReset(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	client := server.Client()
+
+	server.Respond(RouteHealthCheck, http.StatusServiceUnavailable, map[string]string{"detail": "down"})
+	if _, err := client.HealthCheck(); !graphiti.IsServerError(err) {
+		t.Errorf("HealthCheck error = %v, want a server error", err)
+	}
+
+	server.Reset()
+	if _, err := client.HealthCheck(); err != nil {
+		t.Errorf("HealthCheck after Reset: %v", err)
+	}
+}
+
+func TestMockServerDelay(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+	server.SetDelay(time.Second)
+
+	client := server.Client(graphiti.WithTimeout(50 * time.Millisecond))
+	if _, err := client.HealthCheck(); err == nil {
+		t.Error("HealthCheck succeeded despite a delay longer than the client timeout")
+	}
+}
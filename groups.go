@@ -0,0 +1,71 @@
+package graphiti
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// createGroupRequest represents a request to create a group
+type createGroupRequest struct {
+	GroupID     string                 `json:"group_id"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// GroupOption is a functional option for CreateGroup
+type GroupOption func(*createGroupRequest)
+
+// WithGroupDescription sets the description of the created group
+func WithGroupDescription(description string) GroupOption {
+	return func(r *createGroupRequest) {
+		r.Description = description
+	}
+}
+
+// WithGroupMetadata sets the metadata of the created group
+func WithGroupMetadata(metadata map[string]interface{}) GroupOption {
+	return func(r *createGroupRequest) {
+		r.Metadata = metadata
+	}
+}
+
+// CreateGroup creates a group. It is idempotent: creating a group that
+// already exists succeeds and leaves the group unchanged.
+//
+// Servers without a group endpoint create groups implicitly on their first
+// write (AddMessages, AddEntityNode, ...). With such servers CreateGroup only
+// succeeds without options, returning a GroupInfo holding just the group ID;
+// a description or metadata makes it fail with ErrUnsupported.
+func (c *Client) CreateGroup(groupID string, opts ...GroupOption) (*GroupInfo, error) {
+	if groupID == "" {
+		return nil, invalidf("group_id is required")
+	}
+
+	request := createGroupRequest{GroupID: groupID}
+	for _, opt := range opts {
+		opt(&request)
+	}
+
+	var result GroupInfo
+	err := c.do(http.MethodPost, "/group", request, &result)
+	switch {
+	case err == nil:
+		return &result, nil
+	case statusCode(err) == http.StatusConflict:
+		return &GroupInfo{GroupID: groupID}, nil
+	case !isMissing(err):
+		return nil, err
+	case request.Description != "" || request.Metadata != nil:
+		return nil, fmt.Errorf("create group %s with description or metadata: %w", groupID, ErrUnsupported)
+	}
+	return &GroupInfo{GroupID: groupID}, nil
+}
+
+// GroupExists reports whether the group exists. With servers that create
+// groups implicitly, a group exists once it holds any episode, node or edge.
+func (c *Client) GroupExists(groupID string) (bool, error) {
+	if groupID == "" {
+		return false, invalidf("group_id is required")
+	}
+	return c.groupExists(groupID)
+}
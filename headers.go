@@ -0,0 +1,28 @@
+package graphiti
+
+import (
+	"context"
+	"net/http"
+)
+
+// idempotencyKeyHeader carries AddMessagesRequest.IdempotencyKey
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// requestHeadersKey is the context key for per-request headers
+type requestHeadersKey struct{}
+
+// withRequestHeader returns a context that adds a header to requests sent with it
+func withRequestHeader(ctx context.Context, key, value string) context.Context {
+	headers := requestHeaders(ctx).Clone()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+	headers.Set(key, value)
+	return context.WithValue(ctx, requestHeadersKey{}, headers)
+}
+
+// requestHeaders returns the per-request headers stored in ctx
+func requestHeaders(ctx context.Context) http.Header {
+	headers, _ := ctx.Value(requestHeadersKey{}).(http.Header)
+	return headers
+}
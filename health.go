@@ -0,0 +1,152 @@
+package graphiti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ClockSkewThreshold is the clock skew above which CheckClockSkew reports ErrClockSkew
+const ClockSkewThreshold = time.Minute
+
+// ErrClockSkew is returned when the local and server clocks disagree by more than ClockSkewThreshold
+var ErrClockSkew = errors.New("clock skew with server exceeds threshold")
+
+// ServerStatus represents the last known server health from background probing
+type ServerStatus struct {
+	Healthy   bool
+	Status    string
+	Err       error
+	CheckedAt time.Time
+}
+
+// healthProbe periodically checks server health in the background
+type healthProbe struct {
+	interval time.Duration
+	mx       sync.RWMutex
+	status   ServerStatus
+	stop     chan struct{}
+	done     chan struct{}
+	once     sync.Once
+}
+
+// WithBackgroundHealthCheck periodically calls HealthCheck in a background
+// goroutine and exposes the result via ServerStatus. Call Close to stop it.
+// A non-positive interval is a configuration error and starts no goroutine.
+func WithBackgroundHealthCheck(interval time.Duration) ClientOption {
+	return func(c *Client) {
+		if interval <= 0 {
+			c.setConfigErr(fmt.Errorf("background health check interval must be positive, got %s", interval))
+			c.health = nil
+			return
+		}
+		c.health = &healthProbe{
+			interval: interval,
+			stop:     make(chan struct{}),
+			done:     make(chan struct{}),
+		}
+	}
+}
+
+// run probes the server until stopped
+func (p *healthProbe) run(c *Client) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.probe(c)
+
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probe performs a single health check and records the result
+func (p *healthProbe) probe(c *Client) {
+	status := ServerStatus{CheckedAt: time.Now()}
+	// bypass the response cache so the status is never stale
+	var result HealthCheckResponse
+	err := c.doContext(withoutCache(context.Background()), http.MethodGet, "/healthcheck", nil, &result)
+	if err != nil {
+		status.Err = err
+	} else {
+		status.Healthy = true
+		status.Status = result.Status
+	}
+
+	p.mx.Lock()
+	p.status = status
+	p.mx.Unlock()
+}
+
+// get returns the last recorded status
+func (p *healthProbe) get() ServerStatus {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+	return p.status
+}
+
+// close stops the probing goroutine and waits for it to exit
+func (p *healthProbe) close() {
+	p.once.Do(func() {
+		close(p.stop)
+	})
+	<-p.done
+}
+
+// ServerStatus returns the last known server status from background health
+// checks. It reports false if background health checking is not enabled.
+func (c *Client) ServerStatus() (ServerStatus, bool) {
+	if c.health == nil {
+		return ServerStatus{}, false
+	}
+	return c.health.get(), true
+}
+
+// Close releases background resources held by the client
+func (c *Client) Close() error {
+	if c.health != nil {
+		c.health.close()
+	}
+	return nil
+}
+
+// CheckClockSkew compares the server's Date response header with the local
+// clock and returns the server clock offset (positive if the server is ahead).
+// The Date header has one-second precision. If the skew exceeds
+// ClockSkewThreshold, the skew is returned together with an error wrapping ErrClockSkew.
+func (c *Client) CheckClockSkew(ctx context.Context) (time.Duration, error) {
+	sent := time.Now()
+	resp, err := c.send(ctx, http.MethodGet, "/healthcheck", nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	received := time.Now()
+
+	date := resp.Header.Get("Date")
+	if date == "" {
+		return 0, fmt.Errorf("server response has no Date header")
+	}
+	serverTime, err := http.ParseTime(date)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse server Date header %q: %w", date, err)
+	}
+
+	// compare against the midpoint of the round trip to cancel network latency
+	local := sent.Add(received.Sub(sent) / 2)
+	skew := serverTime.Sub(local).Truncate(time.Second)
+	if skew > ClockSkewThreshold || skew < -ClockSkewThreshold {
+		return skew, fmt.Errorf("%w: server clock is off by %s", ErrClockSkew, skew)
+	}
+
+	return skew, nil
+}
@@ -0,0 +1,50 @@
+package graphiti_test
+
+import (
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestBackgroundHealthCheck(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	client := server.Client(graphiti.WithBackgroundHealthCheck(time.Hour))
+	defer client.Close()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		status, ok := client.ServerStatus()
+		if !ok {
+			t.Fatal("ServerStatus reports background health checking disabled")
+		}
+		if status.Healthy {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server never reported healthy: %+v", status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBackgroundHealthCheckRejectsNonPositiveInterval(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		client := server.Client(graphiti.WithBackgroundHealthCheck(interval))
+		if _, ok := client.ServerStatus(); ok {
+			t.Errorf("interval %s: background health checking was enabled", interval)
+		}
+		if _, err := client.HealthCheck(); err == nil {
+			t.Errorf("interval %s: HealthCheck succeeded despite the invalid configuration", interval)
+		}
+		if err := client.Close(); err != nil {
+			t.Errorf("interval %s: Close: %v", interval, err)
+		}
+	}
+}
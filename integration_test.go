@@ -0,0 +1,349 @@
+//go:build integration
+
+package graphiti_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+// Integration tests run against a live Graphiti server:
+//
+//	GRAPHITI_URL=http://localhost:8000 go test -tags integration ./...
+
+const (
+	pollAttempts = 12
+	pollInterval = 5 * time.Second
+)
+
+func newIntegrationClient(t *testing.T) *graphiti.Client {
+	t.Helper()
+
+	baseURL := os.Getenv("GRAPHITI_URL")
+	if baseURL == "" {
+		t.Skip("GRAPHITI_URL is not set, skipping integration tests")
+	}
+
+	client := graphiti.NewClient(baseURL, graphiti.WithTimeout(60*time.Second))
+	if _, err := client.HealthCheck(); err != nil {
+		t.Fatalf("server at %s is not healthy: %v", baseURL, err)
+	}
+
+	return client
+}
+
+func newGroupID(t *testing.T, client *graphiti.Client) string {
+	t.Helper()
+
+	groupID := fmt.Sprintf("go-client-it-%d", time.Now().UnixNano())
+	t.Cleanup(func() {
+		if _, err := client.DeleteGroup(groupID); err != nil {
+			t.Logf("failed to delete group %s: %v", groupID, err)
+		}
+	})
+
+	return groupID
+}
+
+func waitForEpisodes(t *testing.T, client *graphiti.Client, groupID string, want int) []graphiti.Episode {
+	t.Helper()
+
+	episodes, err := client.WaitForEpisodes(context.Background(), groupID, want,
+		graphiti.WithPollInterval(pollInterval),
+		graphiti.WithMaxAttempts(pollAttempts))
+	if err != nil {
+		t.Fatalf("episodes for group %s were not processed: %v", groupID, err)
+	}
+
+	return episodes
+}
+
+func TestIntegrationHealthCheck(t *testing.T) {
+	client := newIntegrationClient(t)
+
+	health, err := client.HealthCheck()
+	if err != nil {
+		t.Fatalf("HealthCheck failed: %v", err)
+	}
+	if health.Status == "" {
+		t.Error("expected non-empty health status")
+	}
+}
+
+func TestIntegrationMessagesRoundTrip(t *testing.T) {
+	client := newIntegrationClient(t)
+	groupID := newGroupID(t, client)
+
+	now := time.Now().UTC()
+	result, err := client.AddMessages(graphiti.AddMessagesRequest{
+		GroupID: groupID,
+		Messages: []graphiti.Message{
+			{
+				Content:           "Nmap scan of 10.0.0.5 found OpenSSH 7.4 on port 22",
+				Name:              "recon",
+				Author:            "pentester",
+				Timestamp:         now.Add(-time.Hour),
+				SourceDescription: "integration test",
+			},
+			{
+				Content:           "OpenSSH 7.4 on 10.0.0.5 is vulnerable to CVE-2018-15473",
+				Name:              "analysis",
+				Author:            "pentester",
+				Timestamp:         now,
+				SourceDescription: "integration test",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddMessages failed: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("AddMessages was not successful: %s", result.Message)
+	}
+
+	episodes := waitForEpisodes(t, client, groupID, 2)
+	for _, episode := range episodes {
+		if episode.GroupID != groupID {
+			t.Errorf("episode %s has group %q, want %q", episode.UUID, episode.GroupID, groupID)
+		}
+	}
+
+	search, err := client.Search(graphiti.SearchQuery{
+		GroupIDs: &[]string{groupID},
+		Query:    "OpenSSH vulnerability",
+		MaxFacts: 10,
+	})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	for _, fact := range search.Facts {
+		edge, err := client.GetEntityEdge(fact.UUID)
+		if err != nil {
+			t.Fatalf("GetEntityEdge(%s) failed: %v", fact.UUID, err)
+		}
+		if edge.UUID != fact.UUID {
+			t.Errorf("GetEntityEdge returned %s, want %s", edge.UUID, fact.UUID)
+		}
+	}
+
+	memory, err := client.GetMemory(graphiti.GetMemoryRequest{
+		GroupID:  groupID,
+		MaxFacts: 5,
+		Messages: []graphiti.Message{
+			{Content: "What is vulnerable on 10.0.0.5?", Author: "user", Timestamp: now},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetMemory failed: %v", err)
+	}
+	if len(memory.Facts) > 5 {
+		t.Errorf("GetMemory returned %d facts, want at most 5", len(memory.Facts))
+	}
+
+	if _, err := client.DeleteEpisode(episodes[0].UUID); err != nil {
+		t.Fatalf("DeleteEpisode failed: %v", err)
+	}
+	remaining, err := client.GetEpisodes(groupID, 10)
+	if err != nil {
+		t.Fatalf("GetEpisodes failed: %v", err)
+	}
+	for _, episode := range remaining {
+		if episode.UUID == episodes[0].UUID {
+			t.Errorf("episode %s is still present after deletion", episode.UUID)
+		}
+	}
+}
+
+func TestIntegrationEntityNode(t *testing.T) {
+	client := newIntegrationClient(t)
+	groupID := newGroupID(t, client)
+
+	nodeUUID := fmt.Sprintf("go-client-it-node-%d", time.Now().UnixNano())
+	node, err := client.AddEntityNode(graphiti.AddEntityNodeRequest{
+		UUID:    nodeUUID,
+		GroupID: groupID,
+		Name:    "Integration Test Host",
+		Summary: "Host created by the Go client integration tests",
+	})
+	if err != nil {
+		t.Fatalf("AddEntityNode failed: %v", err)
+	}
+	if node.UUID != nodeUUID {
+		t.Errorf("AddEntityNode returned UUID %s, want %s", node.UUID, nodeUUID)
+	}
+	if node.GroupID != groupID {
+		t.Errorf("AddEntityNode returned group %q, want %q", node.GroupID, groupID)
+	}
+}
+
+func TestIntegrationEntityEdge(t *testing.T) {
+	client := newIntegrationClient(t)
+	groupID := newGroupID(t, client)
+
+	suffix := time.Now().UnixNano()
+	nodeUUIDs := make([]string, 2)
+	for i := range nodeUUIDs {
+		nodeUUIDs[i] = fmt.Sprintf("go-client-it-node-%d-%d", suffix, i)
+		if _, err := client.AddEntityNode(graphiti.AddEntityNodeRequest{
+			UUID:    nodeUUIDs[i],
+			GroupID: groupID,
+			Name:    fmt.Sprintf("Integration Test Node %d", i),
+		}); err != nil {
+			t.Fatalf("AddEntityNode failed: %v", err)
+		}
+	}
+
+	edgeUUID := fmt.Sprintf("go-client-it-edge-%d", suffix)
+	edge, err := client.AddEntityEdge(graphiti.AddEntityEdgeRequest{
+		UUID:           edgeUUID,
+		GroupID:        groupID,
+		SourceNodeUUID: nodeUUIDs[0],
+		TargetNodeUUID: nodeUUIDs[1],
+		Fact:           "Integration Test Node 0 connects to Integration Test Node 1",
+	})
+	if err != nil {
+		t.Fatalf("AddEntityEdge failed: %v", err)
+	}
+	if edge.UUID != edgeUUID {
+		t.Errorf("AddEntityEdge returned UUID %s, want %s", edge.UUID, edgeUUID)
+	}
+	if edge.SourceNodeUUID != nodeUUIDs[0] || edge.TargetNodeUUID != nodeUUIDs[1] {
+		t.Errorf("AddEntityEdge returned %s -> %s, want %s -> %s",
+			edge.SourceNodeUUID, edge.TargetNodeUUID, nodeUUIDs[0], nodeUUIDs[1])
+	}
+}
+
+func TestIntegrationUpdateEntityNode(t *testing.T) {
+	client := newIntegrationClient(t)
+	groupID := newGroupID(t, client)
+
+	nodeUUID := fmt.Sprintf("go-client-it-node-%d", time.Now().UnixNano())
+	if _, err := client.AddEntityNode(graphiti.AddEntityNodeRequest{
+		UUID:    nodeUUID,
+		GroupID: groupID,
+		Name:    "Integration Test Host",
+		Summary: "Initial summary",
+	}); err != nil {
+		t.Fatalf("AddEntityNode failed: %v", err)
+	}
+
+	updated, err := client.UpdateEntityNode(graphiti.UpdateEntityNodeRequest{
+		UUID:    nodeUUID,
+		Summary: "Updated summary",
+	})
+	if err != nil {
+		t.Fatalf("UpdateEntityNode failed: %v", err)
+	}
+	if updated.Summary != "Updated summary" {
+		t.Errorf("UpdateEntityNode returned summary %q, want %q", updated.Summary, "Updated summary")
+	}
+
+	_, err = client.UpdateEntityNode(graphiti.UpdateEntityNodeRequest{
+		UUID:    nodeUUID + "-missing",
+		Summary: "Updated summary",
+	})
+	if !graphiti.IsNotFound(err) {
+		t.Errorf("UpdateEntityNode of a missing node returned %v, want a not found error", err)
+	}
+}
+
+func TestIntegrationAdvancedSearches(t *testing.T) {
+	client := newIntegrationClient(t)
+	groupID := newGroupID(t, client)
+
+	now := time.Now().UTC()
+	if _, err := client.AddMessages(graphiti.AddMessagesRequest{
+		GroupID: groupID,
+		Messages: []graphiti.Message{
+			{
+				Content:   "SQL injection in the login form of 10.0.0.7 bypassed authentication",
+				Name:      "webapp",
+				Author:    "pentester",
+				Timestamp: now,
+			},
+		},
+	}); err != nil {
+		t.Fatalf("AddMessages failed: %v", err)
+	}
+	waitForEpisodes(t, client, groupID, 1)
+
+	t.Run("TemporalWindow", func(t *testing.T) {
+		result, err := client.TemporalWindowSearch(graphiti.TemporalSearchRequest{
+			Query:      "SQL injection",
+			GroupID:    &groupID,
+			TimeStart:  now.Add(-time.Hour),
+			TimeEnd:    now.Add(time.Hour),
+			MaxResults: 5,
+		})
+		if err != nil {
+			t.Fatalf("TemporalWindowSearch failed: %v", err)
+		}
+		if len(result.Edges) != len(result.EdgeScores) {
+			t.Errorf("got %d edges and %d edge scores", len(result.Edges), len(result.EdgeScores))
+		}
+	})
+
+	t.Run("DiverseResults", func(t *testing.T) {
+		if _, err := client.DiverseResultsSearch(graphiti.DiverseSearchRequest{
+			Query:          "authentication bypass",
+			GroupID:        &groupID,
+			DiversityLevel: graphiti.DiversityMedium,
+			MaxResults:     5,
+		}); err != nil {
+			t.Fatalf("DiverseResultsSearch failed: %v", err)
+		}
+	})
+
+	t.Run("EpisodeContext", func(t *testing.T) {
+		result, err := client.EpisodeContextSearch(graphiti.EpisodeContextSearchRequest{
+			Query:      "login form",
+			GroupID:    &groupID,
+			MaxResults: 5,
+		})
+		if err != nil {
+			t.Fatalf("EpisodeContextSearch failed: %v", err)
+		}
+		if len(result.Episodes) != len(result.RerankerScores) {
+			t.Errorf("got %d episodes and %d scores", len(result.Episodes), len(result.RerankerScores))
+		}
+	})
+
+	t.Run("SuccessfulTools", func(t *testing.T) {
+		if _, err := client.SuccessfulToolsSearch(graphiti.SuccessfulToolsSearchRequest{
+			Query:       "successful exploits",
+			GroupID:     &groupID,
+			MinMentions: 1,
+			MaxResults:  5,
+		}); err != nil {
+			t.Fatalf("SuccessfulToolsSearch failed: %v", err)
+		}
+	})
+
+	t.Run("RecentContext", func(t *testing.T) {
+		if _, err := client.RecentContextSearch(graphiti.RecentContextSearchRequest{
+			Query:           "recent findings",
+			GroupID:         &groupID,
+			RecencyDuration: 6 * time.Hour,
+			MaxResults:      5,
+		}); err != nil {
+			t.Fatalf("RecentContextSearch failed: %v", err)
+		}
+	})
+
+	t.Run("EntityByLabel", func(t *testing.T) {
+		if _, err := client.EntityByLabelSearch(graphiti.EntityByLabelSearchRequest{
+			Query:      "hosts",
+			GroupID:    &groupID,
+			NodeLabels: []string{"Entity"},
+			MaxResults: 5,
+		}); err != nil {
+			t.Fatalf("EntityByLabelSearch failed: %v", err)
+		}
+	})
+}
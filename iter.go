@@ -0,0 +1,116 @@
+package graphiti
+
+import "iter"
+
+// withScores yields items paired with their scores, using zero for missing scores
+func withScores[T any](items []T, scores []float64) iter.Seq2[T, float64] {
+	return func(yield func(T, float64) bool) {
+		for i, item := range items {
+			if !yield(item, scoreAt(scores, i)) {
+				return
+			}
+		}
+	}
+}
+
+// EdgesWithScores yields edges paired with their scores
+func (r *TemporalSearchResponse) EdgesWithScores() iter.Seq2[EdgeResult, float64] {
+	return withScores(r.Edges, r.EdgeScores)
+}
+
+// NodesWithScores yields nodes paired with their scores
+func (r *TemporalSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.Nodes, r.NodeScores)
+}
+
+// EpisodesWithScores yields episodes paired with their scores
+func (r *TemporalSearchResponse) EpisodesWithScores() iter.Seq2[EpisodeResult, float64] {
+	return withScores(r.Episodes, r.EpisodeScores)
+}
+
+// EdgesWithScores yields edges paired with scores derived from their
+// distances as 1/(1+distance), consistent with UnifiedResults
+func (r *EntityRelationshipSearchResponse) EdgesWithScores() iter.Seq2[EdgeResult, float64] {
+	return withScores(r.Edges, distancesToScores(r.EdgeDistances))
+}
+
+// NodesWithScores yields nodes paired with scores derived from their
+// distances as 1/(1+distance), consistent with UnifiedResults
+func (r *EntityRelationshipSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.Nodes, distancesToScores(r.NodeDistances))
+}
+
+// EdgesWithScores yields edges paired with their MMR scores
+func (r *DiverseSearchResponse) EdgesWithScores() iter.Seq2[EdgeResult, float64] {
+	return withScores(r.Edges, r.EdgeMMRScores)
+}
+
+// NodesWithScores yields nodes paired with their MMR scores
+func (r *DiverseSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.Nodes, r.NodeMMRScores)
+}
+
+// EpisodesWithScores yields episodes paired with their scores
+func (r *DiverseSearchResponse) EpisodesWithScores() iter.Seq2[EpisodeResult, float64] {
+	return withScores(r.Episodes, r.EpisodeScores)
+}
+
+// CommunitiesWithScores yields communities paired with their MMR scores
+func (r *DiverseSearchResponse) CommunitiesWithScores() iter.Seq2[CommunityResult, float64] {
+	return withScores(r.Communities, r.CommunityMMRScores)
+}
+
+// NodesWithScores yields mentioned nodes paired with their scores
+func (r *EpisodeContextSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.MentionedNodes, r.MentionedNodeScores)
+}
+
+// EpisodesWithScores yields episodes paired with their reranker scores
+func (r *EpisodeContextSearchResponse) EpisodesWithScores() iter.Seq2[EpisodeResult, float64] {
+	return withScores(r.Episodes, r.RerankerScores)
+}
+
+// EdgesWithScores yields edges paired with their mention counts
+func (r *SuccessfulToolsSearchResponse) EdgesWithScores() iter.Seq2[EdgeResult, float64] {
+	return withScores(r.Edges, r.EdgeMentionCounts)
+}
+
+// NodesWithScores yields nodes paired with their mention counts
+func (r *SuccessfulToolsSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.Nodes, r.NodeMentionCounts)
+}
+
+// EpisodesWithScores yields episodes paired with their scores
+func (r *SuccessfulToolsSearchResponse) EpisodesWithScores() iter.Seq2[EpisodeResult, float64] {
+	return withScores(r.Episodes, r.EpisodeScores)
+}
+
+// EdgesWithScores yields edges paired with their scores
+func (r *RecentContextSearchResponse) EdgesWithScores() iter.Seq2[EdgeResult, float64] {
+	return withScores(r.Edges, r.EdgeScores)
+}
+
+// NodesWithScores yields nodes paired with their scores
+func (r *RecentContextSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.Nodes, r.NodeScores)
+}
+
+// EpisodesWithScores yields episodes paired with their scores
+func (r *RecentContextSearchResponse) EpisodesWithScores() iter.Seq2[EpisodeResult, float64] {
+	return withScores(r.Episodes, r.EpisodeScores)
+}
+
+// EdgesWithScores yields edges paired with their scores
+func (r *EntityByLabelSearchResponse) EdgesWithScores() iter.Seq2[EdgeResult, float64] {
+	return withScores(r.Edges, r.EdgeScores)
+}
+
+// NodesWithScores yields nodes paired with their scores
+func (r *EntityByLabelSearchResponse) NodesWithScores() iter.Seq2[NodeResult, float64] {
+	return withScores(r.Nodes, r.NodeScores)
+}
+
+// CommunitiesWithScores yields communities paired with their scores
+func (r *CommunitySearchResponse) CommunitiesWithScores() iter.Seq2[CommunityResult, float64] {
+	return withScores(r.Communities, r.CommunityScores)
+}
@@ -0,0 +1,49 @@
+package graphiti
+
+import (
+	"context"
+	"net/url"
+	"sort"
+)
+
+// Node labels commonly used with EntityByLabelSearch. LabelEntity is assigned
+// by the server to every entity node; the others depend on the entity types
+// extracted by the server and are provided to avoid typos.
+const (
+	LabelEntity        = "Entity"
+	LabelService       = "SERVICE"
+	LabelVulnerability = "VULNERABILITY"
+	LabelIPAddress     = "IP_ADDRESS"
+)
+
+// Edge types for EntityRelationshipSearchRequest.EdgeTypes and
+// EntityByLabelSearchRequest.EdgeTypes
+const (
+	EdgeTypeRelatesTo = "RELATES_TO"
+	EdgeTypeMentions  = "MENTIONS"
+)
+
+// DiscoverLabels returns the distinct labels of the entity nodes in a group,
+// sorted alphabetically. It returns ErrUnsupported if the server cannot list
+// the nodes of a group.
+func (c *Client) DiscoverLabels(groupID string) ([]string, error) {
+	seen := make(map[string]struct{})
+	err := listGroupPages(context.Background(), c, "labels of group "+groupID, "/entity-nodes/"+url.PathEscape(groupID), func(page []EntityNode) error {
+		for _, node := range page {
+			for _, label := range node.Labels {
+				seen[label] = struct{}{}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make([]string, 0, len(seen))
+	for label := range seen {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels, nil
+}
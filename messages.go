@@ -0,0 +1,61 @@
+package graphiti
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// splitMessages splits messages whose content exceeds maxBytes into parts.
+// Each part keeps the original author, timestamp and source description and
+// gets the name "<name> [part i/n]", or "[part i/n]" when the message has no
+// name, so the original content is restored by concatenating parts with the
+// same base name in part order. Parts never get
+// the original UUID since it must stay unique per episode.
+func splitMessages(messages []Message, maxBytes int) []Message {
+	result := make([]Message, 0, len(messages))
+	for _, msg := range messages {
+		if len(msg.Content) <= maxBytes {
+			result = append(result, msg)
+			continue
+		}
+
+		chunks := splitContent(msg.Content, maxBytes)
+		for i, chunk := range chunks {
+			part := msg
+			part.UUID = nil
+			part.Content = chunk
+			part.Name = fmt.Sprintf("[part %d/%d]", i+1, len(chunks))
+			if msg.Name != "" {
+				part.Name = msg.Name + " " + part.Name
+			}
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
+
+// splitContent splits content into chunks of at most maxBytes bytes without
+// breaking UTF-8 encoded runes.
+func splitContent(content string, maxBytes int) []string {
+	var chunks []string
+	for len(content) > maxBytes {
+		cut := maxBytes
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		if cut == 0 {
+			// a single rune is wider than maxBytes, keep it whole
+			_, size := utf8.DecodeRuneInString(content)
+			cut = size
+		}
+		chunks = append(chunks, content[:cut])
+		content = content[cut:]
+	}
+
+	// an oversized last rune leaves nothing behind, which must not become an empty part
+	if content == "" && len(chunks) > 0 {
+		return chunks
+	}
+	return append(chunks, content)
+}
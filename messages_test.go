@@ -0,0 +1,78 @@
+package graphiti
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		maxBytes int
+		want     []string
+	}{
+		{"exactly max bytes", "abcd", 4, []string{"abcd"}},
+		{"one byte over", "abcde", 4, []string{"abcd", "e"}},
+		{"empty", "", 4, []string{""}},
+		// "é" is two bytes and would straddle the cut after byte 4
+		{"rune straddling the cut", "abcé", 4, []string{"abc", "é"}},
+		// "€" is three bytes, wider than maxBytes
+		{"rune wider than max bytes", "€€", 2, []string{"€", "€"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitContent(tt.content, tt.maxBytes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitContent(%q, %d) = %q, want %q", tt.content, tt.maxBytes, got, tt.want)
+			}
+			if joined := strings.Join(got, ""); joined != tt.content {
+				t.Errorf("parts join to %q, want %q", joined, tt.content)
+			}
+		})
+	}
+}
+
+func TestSplitMessages(t *testing.T) {
+	uuid := "episode-1"
+	timestamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	message := Message{
+		Content:   "abcde",
+		UUID:      &uuid,
+		Name:      "report",
+		Author:    "alice",
+		Timestamp: timestamp,
+	}
+
+	unnamed := message
+	unnamed.Name = ""
+
+	tests := []struct {
+		name     string
+		message  Message
+		maxBytes int
+		want     []Message
+	}{
+		{"exactly max bytes", message, 5, []Message{message}},
+		{"one byte over", message, 4, []Message{
+			{Content: "abcd", Name: "report [part 1/2]", Author: "alice", Timestamp: timestamp},
+			{Content: "e", Name: "report [part 2/2]", Author: "alice", Timestamp: timestamp},
+		}},
+		{"unnamed", unnamed, 4, []Message{
+			{Content: "abcd", Name: "[part 1/2]", Author: "alice", Timestamp: timestamp},
+			{Content: "e", Name: "[part 2/2]", Author: "alice", Timestamp: timestamp},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitMessages([]Message{tt.message}, tt.maxBytes)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitMessages(%d) = %+v, want %+v", tt.maxBytes, got, tt.want)
+			}
+		})
+	}
+}
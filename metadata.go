@@ -0,0 +1,132 @@
+package graphiti
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MetadataString returns the metadata value for key if it is a string
+func (n EntityNode) MetadataString(key string) (string, bool) {
+	return metadataString(n.Metadata, key)
+}
+
+// MetadataInt returns the metadata value for key if it is an integral number
+func (n EntityNode) MetadataInt(key string) (int64, bool) {
+	return metadataInt(n.Metadata, key)
+}
+
+// MetadataFloat returns the metadata value for key if it is a number
+func (n EntityNode) MetadataFloat(key string) (float64, bool) {
+	return metadataFloat(n.Metadata, key)
+}
+
+// MetadataTime returns the metadata value for key if it is an RFC 3339 timestamp
+func (n EntityNode) MetadataTime(key string) (time.Time, bool) {
+	return metadataTime(n.Metadata, key)
+}
+
+// MetadataInto decodes the metadata value for key into dst, which must be a
+// pointer, by re-encoding it as JSON. It returns ErrMetadataKeyNotFound if
+// the key is not set.
+func (n EntityNode) MetadataInto(key string, dst interface{}) error {
+	return metadataInto(n.Metadata, key, dst)
+}
+
+// MetadataString returns the metadata value for key if it is a string
+func (e Episode) MetadataString(key string) (string, bool) {
+	return metadataString(e.Metadata, key)
+}
+
+// MetadataInt returns the metadata value for key if it is an integral number
+func (e Episode) MetadataInt(key string) (int64, bool) {
+	return metadataInt(e.Metadata, key)
+}
+
+// MetadataFloat returns the metadata value for key if it is a number
+func (e Episode) MetadataFloat(key string) (float64, bool) {
+	return metadataFloat(e.Metadata, key)
+}
+
+// MetadataTime returns the metadata value for key if it is an RFC 3339 timestamp
+func (e Episode) MetadataTime(key string) (time.Time, bool) {
+	return metadataTime(e.Metadata, key)
+}
+
+// MetadataInto decodes the metadata value for key into dst, which must be a
+// pointer, by re-encoding it as JSON. It returns ErrMetadataKeyNotFound if
+// the key is not set.
+func (e Episode) MetadataInto(key string, dst interface{}) error {
+	return metadataInto(e.Metadata, key, dst)
+}
+
+func metadataString(metadata map[string]interface{}, key string) (string, bool) {
+	value, ok := metadata[key].(string)
+	return value, ok
+}
+
+func metadataFloat(metadata map[string]interface{}, key string) (float64, bool) {
+	switch value := metadata[key].(type) {
+	case float64:
+		return value, true
+	case float32:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case json.Number:
+		f, err := value.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func metadataInt(metadata map[string]interface{}, key string) (int64, bool) {
+	switch value := metadata[key].(type) {
+	case int:
+		return int64(value), true
+	case int64:
+		return value, true
+	case json.Number:
+		i, err := value.Int64()
+		return i, err == nil
+	}
+
+	// JSON numbers decode as float64; accept them only if they are integral
+	f, ok := metadataFloat(metadata, key)
+	if !ok || f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+func metadataTime(metadata map[string]interface{}, key string) (time.Time, bool) {
+	switch value := metadata[key].(type) {
+	case time.Time:
+		return value, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+func metadataInto(metadata map[string]interface{}, key string, dst interface{}) error {
+	value, ok := metadata[key]
+	if !ok {
+		return fmt.Errorf("metadata key %q: %w", key, ErrMetadataKeyNotFound)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata key %q: %w", key, err)
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("failed to decode metadata key %q: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package graphiti
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MetricsObserver receives a report for every HTTP request attempt, including
+// retries. Path is the normalized endpoint (e.g. "/episodes/{group_id}"), so
+// it is safe to use as a metric label. StatusCode is zero when no response
+// was received. Implementations must be safe for concurrent use.
+type MetricsObserver interface {
+	ObserveRequest(method, path string, statusCode int, duration time.Duration, err error)
+}
+
+// WithMetrics reports the latency and outcome of every request to observer
+func WithMetrics(observer MetricsObserver) ClientOption {
+	return func(c *Client) {
+		c.metrics = observer
+	}
+}
+
+// routeParams maps the first segment of parameterized endpoints to the
+// placeholder that replaces their second segment in metrics
+var routeParams = map[string]string{
+	"communities":  "{group_id}",
+	"entity-edge":  "{uuid}",
+	"entity-edges": "{group_id}",
+	"entity-node":  "{uuid}",
+	"entity-nodes": "{group_id}",
+	"episode":      "{uuid}",
+	"episodes":     "{group_id}",
+	"group":        "{group_id}",
+	"job":          "{job_id}",
+	"jobs":         "{group_id}",
+	"reindex":      "{group_id}",
+}
+
+// staticPaths are endpoints under a parameterized prefix that take no parameter
+var staticPaths = map[string]bool{
+	"/entity-node/merge": true,
+}
+
+// normalizePath strips the query and replaces path parameters with placeholders
+func normalizePath(path string) string {
+	if idx := strings.IndexByte(path, '?'); idx >= 0 {
+		path = path[:idx]
+	}
+
+	if staticPaths[path] {
+		return path
+	}
+
+	segments := strings.Split(path, "/")
+	if len(segments) >= 3 {
+		if param, ok := routeParams[segments[1]]; ok {
+			segments[2] = param
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// observe reports a request attempt to the metrics observer, if any
+func (c *Client) observe(method, path string, resp *http.Response, start time.Time, err error) {
+	if c.metrics == nil {
+		return
+	}
+
+	statusCode := 0
+	var apiErr *APIError
+	switch {
+	case resp != nil:
+		statusCode = resp.StatusCode
+	case errors.As(err, &apiErr):
+		statusCode = apiErr.StatusCode
+	}
+
+	c.metrics.ObserveRequest(method, normalizePath(path), statusCode, time.Since(start), err)
+}
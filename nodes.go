@@ -0,0 +1,40 @@
+package graphiti
+
+// mergeLabels returns the union of existing and added labels, keeping the
+// existing order and appending new labels in the order they were given
+func mergeLabels(existing, added []string) []string {
+	if len(existing) == 0 {
+		return added
+	}
+
+	seen := make(map[string]struct{}, len(existing)+len(added))
+	merged := make([]string, 0, len(existing)+len(added))
+	for _, labels := range [][]string{existing, added} {
+		for _, label := range labels {
+			if _, ok := seen[label]; ok {
+				continue
+			}
+			seen[label] = struct{}{}
+			merged = append(merged, label)
+		}
+	}
+
+	return merged
+}
+
+// mergeMetadata returns existing metadata overlaid with the added keys
+func mergeMetadata(existing, added map[string]interface{}) map[string]interface{} {
+	if len(existing) == 0 {
+		return added
+	}
+
+	merged := make(map[string]interface{}, len(existing)+len(added))
+	for k, v := range existing {
+		merged[k] = v
+	}
+	for k, v := range added {
+		merged[k] = v
+	}
+
+	return merged
+}
@@ -0,0 +1,82 @@
+package graphiti_test
+
+import (
+	"reflect"
+	"testing"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestAddEntityNodeLabels(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         []graphiti.ClientOption
+		wantLabels   []string
+		wantMetadata map[string]interface{}
+	}{
+		{
+			name:         "merge",
+			wantLabels:   []string{"Entity", "Host", "Target"},
+			wantMetadata: map[string]interface{}{"os": "linux", "port": float64(22)},
+		},
+		{
+			name:         "replace",
+			opts:         []graphiti.ClientOption{graphiti.WithReplaceLabels()},
+			wantLabels:   []string{"Host", "Target"},
+			wantMetadata: map[string]interface{}{"port": float64(22)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := graphititest.NewMockServer()
+			defer server.Close()
+
+			existing := graphititest.NewEntityNode("node-1", "g", "10.0.0.1")
+			existing.Labels = []string{"Entity", "Host"}
+			existing.Metadata = map[string]interface{}{"os": "linux"}
+			server.AddEntityNodes(existing)
+
+			node, err := server.Client(tt.opts...).AddEntityNode(graphiti.AddEntityNodeRequest{
+				UUID:     "node-1",
+				GroupID:  "g",
+				Name:     "10.0.0.1",
+				Labels:   []string{"Host", "Target"},
+				Metadata: map[string]interface{}{"port": 22},
+			})
+			if err != nil {
+				t.Fatalf("AddEntityNode: %v", err)
+			}
+			if !reflect.DeepEqual(node.Labels, tt.wantLabels) {
+				t.Errorf("returned labels = %v, want %v", node.Labels, tt.wantLabels)
+			}
+			if !reflect.DeepEqual(node.Metadata, tt.wantMetadata) {
+				t.Errorf("returned metadata = %v, want %v", node.Metadata, tt.wantMetadata)
+			}
+
+			stored, _ := server.EntityNode("node-1")
+			if !reflect.DeepEqual(stored.Labels, tt.wantLabels) {
+				t.Errorf("stored labels = %v, want %v", stored.Labels, tt.wantLabels)
+			}
+		})
+	}
+}
+
+func TestAddEntityNodeNew(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	node, err := server.Client().AddEntityNode(graphiti.AddEntityNodeRequest{
+		UUID:    "node-1",
+		GroupID: "g",
+		Name:    "10.0.0.1",
+		Labels:  []string{"Host"},
+	})
+	if err != nil {
+		t.Fatalf("AddEntityNode: %v", err)
+	}
+	if want := []string{"Host"}; !reflect.DeepEqual(node.Labels, want) {
+		t.Errorf("labels = %v, want %v", node.Labels, want)
+	}
+}
@@ -0,0 +1,173 @@
+package graphiti
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// values encodes the options as query parameters
+func (o EpisodePageOptions) values() url.Values {
+	v := url.Values{}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Cursor != "" {
+		v.Set("cursor", o.Cursor)
+	} else if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.Start != nil {
+		v.Set("start", o.Start.UTC().Format(time.RFC3339Nano))
+	}
+	if o.End != nil {
+		v.Set("end", o.End.UTC().Format(time.RFC3339Nano))
+	}
+	return v
+}
+
+// matches reports whether the episode passes the filter
+func (f EpisodeFilter) matches(episode Episode) bool {
+	if f.Name != "" && episode.Name != f.Name {
+		return false
+	}
+	if f.Author != "" && !strings.HasPrefix(episode.Content, f.Author+": ") {
+		return false
+	}
+	return true
+}
+
+// EpisodeIterator lazily pages through the episodes of a group, holding at
+// most one page in memory:
+//
+//	it := client.EpisodesIterator(groupID, 100)
+//	for it.Next() {
+//		episode := it.Episode()
+//	}
+//	if err := it.Err(); err != nil {
+//		// handle the error
+//	}
+type EpisodeIterator struct {
+	client  *Client
+	ctx     context.Context
+	groupID string
+	opts    EpisodePageOptions
+
+	page    []Episode
+	index   int
+	done    bool
+	current Episode
+	err     error
+}
+
+// EpisodesIterator returns an iterator over all episodes of a group that
+// fetches pageSize episodes per request as it advances
+func (c *Client) EpisodesIterator(groupID string, pageSize int) *EpisodeIterator {
+	return c.episodesIterator(context.Background(), groupID, pageSize)
+}
+
+func (c *Client) episodesIterator(ctx context.Context, groupID string, pageSize int) *EpisodeIterator {
+	return &EpisodeIterator{
+		client:  c,
+		ctx:     ctx,
+		groupID: groupID,
+		opts:    EpisodePageOptions{Limit: pageSize},
+	}
+}
+
+// Next advances to the next episode, fetching the next page when needed.
+// It returns false when there are no more episodes or an error occurred.
+func (it *EpisodeIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+
+	it.current = it.page[it.index]
+	it.index++
+	return true
+}
+
+// fetch loads the next page of episodes
+func (it *EpisodeIterator) fetch() {
+	page, err := it.client.getEpisodesPage(it.ctx, it.groupID, it.opts)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.page = page.Episodes
+	it.index = 0
+	it.done = !page.HasMore || len(page.Episodes) == 0
+	it.opts.Cursor = page.NextCursor
+	it.opts.Offset += len(page.Episodes)
+}
+
+// Episode returns the current episode
+func (it *EpisodeIterator) Episode() Episode {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *EpisodeIterator) Err() error {
+	return it.err
+}
+
+// eachEpisode calls fn with every episode of a group, paging through them with
+// an EpisodeIterator. It returns ErrUnsupported if the server cannot page
+// episodes.
+func (c *Client) eachEpisode(ctx context.Context, groupID string, fn func(Episode) error) error {
+	it := c.episodesIterator(ctx, groupID, exportPageSize)
+	fetched := false
+	for it.Next() {
+		fetched = true
+		if err := fn(it.Episode()); err != nil {
+			return err
+		}
+	}
+	if err := it.Err(); err != nil {
+		if !fetched && isMissing(err) {
+			return fmt.Errorf("episode pages of group %s: %w", groupID, ErrUnsupported)
+		}
+		return err
+	}
+	return nil
+}
+
+// listGroupPages is like listPages but returns ErrUnsupported, naming what,
+// if the server cannot list the collection
+func listGroupPages[T any](ctx context.Context, c *Client, what, basePath string, fn func([]T) error) error {
+	fetched := false
+	err := listPages(ctx, c, basePath, func(page []T) error {
+		fetched = true
+		return fn(page)
+	})
+	if err != nil && !fetched && isMissing(err) {
+		return fmt.Errorf("%s: %w", what, ErrUnsupported)
+	}
+	return err
+}
+
+// listPages calls fn with every page of a limit/offset paginated list
+// endpoint, stopping after the first page shorter than exportPageSize
+func listPages[T any](ctx context.Context, c *Client, basePath string, fn func([]T) error) error {
+	for offset := 0; ; offset += exportPageSize {
+		var page []T
+		path := fmt.Sprintf("%s?limit=%d&offset=%d", basePath, exportPageSize, offset)
+		if err := c.doContext(ctx, http.MethodGet, path, nil, &page); err != nil {
+			return err
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+		if len(page) < exportPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package graphiti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultPollInterval    = 5 * time.Second
+	defaultPollMaxAttempts = 12
+)
+
+// ErrPollTimeout is returned when polling gives up before the condition is met
+var ErrPollTimeout = errors.New("polling timed out")
+
+// ErrJobFailed is returned by WaitForJob when the job failed
+var ErrJobFailed = errors.New("job failed")
+
+// pollConfig controls polling helpers
+type pollConfig struct {
+	interval    time.Duration
+	maxAttempts int
+	timeout     time.Duration
+}
+
+// PollOption is a functional option for configuring polling helpers
+type PollOption func(*pollConfig)
+
+// WithPollInterval sets the delay between polling attempts
+func WithPollInterval(interval time.Duration) PollOption {
+	return func(p *pollConfig) {
+		p.interval = interval
+	}
+}
+
+// WithMaxAttempts sets the maximum number of polling attempts,
+// zero or less polls until the context is done
+func WithMaxAttempts(maxAttempts int) PollOption {
+	return func(p *pollConfig) {
+		p.maxAttempts = maxAttempts
+	}
+}
+
+// WithPollTimeout stops polling once another attempt would start after
+// timeout has elapsed, in addition to the attempt limit
+func WithPollTimeout(timeout time.Duration) PollOption {
+	return func(p *pollConfig) {
+		p.timeout = timeout
+	}
+}
+
+func newPollConfig(opts []PollOption) pollConfig {
+	cfg := pollConfig{
+		interval:    defaultPollInterval,
+		maxAttempts: defaultPollMaxAttempts,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// next waits before the next polling attempt. It returns false without
+// waiting when the attempts or the timeout are exhausted, and the context
+// error when ctx is done while waiting.
+func (p pollConfig) next(ctx context.Context, attempt int, start time.Time) (bool, error) {
+	if p.maxAttempts > 0 && attempt >= p.maxAttempts {
+		return false, nil
+	}
+	if p.timeout > 0 && time.Since(start)+p.interval > p.timeout {
+		return false, nil
+	}
+	return true, sleepContext(ctx, p.interval)
+}
+
+// poller tracks the attempts and elapsed time of a wait, so a wait made of
+// several polling steps shares one attempt and timeout budget
+type poller struct {
+	cfg     pollConfig
+	start   time.Time
+	attempt int
+}
+
+func newPoller(opts []PollOption) *poller {
+	return &poller{cfg: newPollConfig(opts), start: time.Now()}
+}
+
+// next counts the attempt that just finished and waits before the next one
+func (p *poller) next(ctx context.Context) (bool, error) {
+	p.attempt++
+	return p.cfg.next(ctx, p.attempt, p.start)
+}
+
+// WaitForEpisodes polls the episodes of a group until at least minCount exist,
+// which makes it a barrier after the asynchronous AddMessages. It returns
+// ErrPollTimeout when the attempts or the timeout are exhausted and an error
+// wrapping the context error as soon as ctx is done. Failed polls are
+// retried; the last failure is included in the timeout error.
+func (c *Client) WaitForEpisodes(ctx context.Context, groupID string, minCount int, opts ...PollOption) ([]Episode, error) {
+	return c.waitForEpisodes(ctx, groupID, minCount, nil, newPoller(opts))
+}
+
+// waitForEpisodes polls the latest minCount episodes of a group until at least
+// minCount of them are not in known, and returns those
+func (c *Client) waitForEpisodes(ctx context.Context, groupID string, minCount int, known map[string]struct{}, p *poller) ([]Episode, error) {
+	path := fmt.Sprintf("/episodes/%s?last_n=%d", url.PathEscape(groupID), max(minCount, 1))
+
+	var lastErr error
+	for {
+		var episodes []Episode
+		err := c.doContext(withoutCache(ctx), http.MethodGet, path, nil, &episodes)
+		if err == nil {
+			episodes = excludeEpisodes(episodes, known)
+		}
+		switch {
+		case ctx.Err() != nil:
+			return nil, fmt.Errorf("waiting for episodes of group %s: %w", groupID, ctx.Err())
+		case err != nil:
+			lastErr = err
+		case len(episodes) >= minCount:
+			return episodes, nil
+		}
+
+		more, err := p.next(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("waiting for episodes of group %s: %w", groupID, err)
+		}
+		if more {
+			continue
+		}
+
+		if lastErr != nil {
+			return nil, fmt.Errorf("%w: %d episodes of group %s not found after %d attempts, last error: %v",
+				ErrPollTimeout, minCount, groupID, p.attempt, lastErr)
+		}
+		return nil, fmt.Errorf("%w: %d episodes of group %s not found after %d attempts",
+			ErrPollTimeout, minCount, groupID, p.attempt)
+	}
+}
+
+// excludeEpisodes returns the episodes whose UUIDs are not in known
+func excludeEpisodes(episodes []Episode, known map[string]struct{}) []Episode {
+	if len(known) == 0 {
+		return episodes
+	}
+	result := make([]Episode, 0, len(episodes))
+	for _, episode := range episodes {
+		if _, ok := known[episode.UUID]; !ok {
+			result = append(result, episode)
+		}
+	}
+	return result
+}
+
+// WaitForJob polls the status of an ingestion job until it completes, which
+// is a more reliable barrier after AddMessages than WaitForEpisodes when the
+// server reports job IDs. It returns the final status together with an error
+// wrapping ErrJobFailed if the job failed, ErrPollTimeout when the attempts
+// or the timeout are exhausted and an error wrapping the context error as
+// soon as ctx is done. If the server does not know the job or cannot report
+// job status, the APIError is returned at once; other failed polls are
+// retried and the last failure is included in the timeout error.
+func (c *Client) WaitForJob(ctx context.Context, jobID string, opts ...PollOption) (*JobStatus, error) {
+	if jobID == "" {
+		return nil, invalidf("job id is required")
+	}
+	return c.waitForJob(ctx, jobID, newPoller(opts))
+}
+
+func (c *Client) waitForJob(ctx context.Context, jobID string, p *poller) (*JobStatus, error) {
+	var lastErr error
+	for {
+		status, err := c.getJobStatus(ctx, jobID)
+		switch {
+		case ctx.Err() != nil:
+			return nil, fmt.Errorf("waiting for job %s: %w", jobID, ctx.Err())
+		case isMissing(err):
+			return nil, fmt.Errorf("waiting for job %s: %w", jobID, err)
+		case err != nil:
+			lastErr = err
+		case status.Status == JobStatusCompleted:
+			return status, nil
+		case status.Status == JobStatusFailed:
+			return status, fmt.Errorf("%w: job %s: %s", ErrJobFailed, jobID, status.Error)
+		}
+
+		more, err := p.next(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("waiting for job %s: %w", jobID, err)
+		}
+		if more {
+			continue
+		}
+
+		if lastErr != nil {
+			return nil, fmt.Errorf("%w: job %s not completed after %d attempts, last error: %v",
+				ErrPollTimeout, jobID, p.attempt, lastErr)
+		}
+		return nil, fmt.Errorf("%w: job %s not completed after %d attempts", ErrPollTimeout, jobID, p.attempt)
+	}
+}
+
+// AddMessagesAndWait adds the messages and blocks until they are processed,
+// returning the episodes they created. Before sending, it records the group's
+// latest episodes, so only episodes created afterwards count towards
+// minEpisodes; episodes added concurrently by other writers still count. If
+// the server reports an ingestion job, it waits for the job first, which also
+// surfaces ErrJobFailed. minEpisodes of zero or less waits for one episode per
+// message, counting each part of a message split by WithMaxMessageBytes. The
+// poll options bound the whole wait: the job and episode polls share one
+// attempt limit and timeout.
+func (c *Client) AddMessagesAndWait(ctx context.Context, request AddMessagesRequest, minEpisodes int, opts ...PollOption) ([]Episode, error) {
+	if minEpisodes <= 0 {
+		minEpisodes = len(request.Messages)
+		if c.maxMessageBytes > 0 {
+			minEpisodes = len(splitMessages(request.Messages, c.maxMessageBytes))
+		}
+	}
+	request.GroupID = c.groupID(request.GroupID)
+
+	// the new episodes displace these from the latest minEpisodes
+	var before []Episode
+	path := fmt.Sprintf("/episodes/%s?last_n=%d", url.PathEscape(request.GroupID), max(minEpisodes, 1))
+	if err := c.doContext(withoutCache(ctx), http.MethodGet, path, nil, &before); err != nil && !isMissing(err) {
+		return nil, fmt.Errorf("failed to list episodes of group %s: %w", request.GroupID, err)
+	}
+	known := make(map[string]struct{}, len(before))
+	for _, episode := range before {
+		known[episode.UUID] = struct{}{}
+	}
+
+	result, err := c.addMessages(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	p := newPoller(opts)
+	if result.JobID != "" {
+		// a server that does not track jobs after all falls back to the episodes
+		if _, err := c.waitForJob(ctx, result.JobID, p); err != nil && !isMissing(err) {
+			return nil, err
+		}
+	}
+
+	return c.waitForEpisodes(ctx, request.GroupID, minEpisodes, known, p)
+}
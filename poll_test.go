@@ -0,0 +1,140 @@
+package graphiti_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestAddMessagesAndWaitIgnoresExistingEpisodes(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	server.AddEpisodes(
+		graphititest.NewEpisode("old-1", "g", "old", time.Now().UTC()),
+		graphititest.NewEpisode("old-2", "g", "old", time.Now().UTC()),
+	)
+
+	// without a job ID only the episodes are polled
+	server.Handle(graphititest.RouteAddMessages, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"message": "queued", "success": true}`))
+	})
+
+	ctx := context.Background()
+	client := server.Client()
+	messages := []graphiti.Message{
+		{Content: "first", Name: "m1", Author: "alice", Timestamp: time.Now().UTC()},
+		{Content: "second", Name: "m2", Author: "alice", Timestamp: time.Now().UTC()},
+	}
+	_, err := client.AddMessagesAndWait(ctx, graphiti.AddMessagesRequest{GroupID: "g", Messages: messages}, 0,
+		graphiti.WithPollInterval(time.Millisecond), graphiti.WithMaxAttempts(3))
+	if !errors.Is(err, graphiti.ErrPollTimeout) {
+		t.Fatalf("AddMessagesAndWait error = %v, want ErrPollTimeout since no new episodes appear", err)
+	}
+}
+
+func TestAddMessagesAndWaitReturnsNewEpisodes(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	server.AddEpisodes(graphititest.NewEpisode("old", "g", "old", time.Now().UTC()))
+
+	client := server.Client(graphiti.WithMaxMessageBytes(4))
+	messages := []graphiti.Message{
+		{Content: "abcdefgh", Name: "long", Author: "alice", Timestamp: time.Now().UTC()},
+		{Content: "abc", Name: "short", Author: "alice", Timestamp: time.Now().UTC()},
+	}
+	episodes, err := client.AddMessagesAndWait(context.Background(), graphiti.AddMessagesRequest{GroupID: "g", Messages: messages}, 0,
+		graphiti.WithPollInterval(time.Millisecond))
+	if err != nil {
+		t.Fatalf("AddMessagesAndWait: %v", err)
+	}
+
+	// the long message is split into two parts
+	if len(episodes) != 3 {
+		t.Fatalf("got %d episodes, want 3", len(episodes))
+	}
+	for _, episode := range episodes {
+		if episode.UUID == "old" {
+			t.Errorf("returned the episode that existed before the call")
+		}
+		if !strings.HasPrefix(episode.Name, "long") && episode.Name != "short" {
+			t.Errorf("unexpected episode %q", episode.Name)
+		}
+	}
+}
+
+func TestAddMessagesAndWaitSharesPollBudget(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	var polls int32
+	server.Handle(graphititest.RouteGetJobStatus, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "` + r.PathValue("job_id") + `", "status": "running"}`))
+	})
+
+	messages := []graphiti.Message{{Content: "hello", Author: "alice", Timestamp: time.Now().UTC()}}
+	_, err := server.Client().AddMessagesAndWait(context.Background(), graphiti.AddMessagesRequest{GroupID: "g", Messages: messages}, 0,
+		graphiti.WithPollInterval(time.Millisecond), graphiti.WithMaxAttempts(3))
+	if !errors.Is(err, graphiti.ErrPollTimeout) {
+		t.Fatalf("AddMessagesAndWait error = %v, want ErrPollTimeout", err)
+	}
+	if got := atomic.LoadInt32(&polls); got != 3 {
+		t.Errorf("job polled %d times, want 3", got)
+	}
+}
+
+func TestAddMessagesAndWaitFallsBackWithoutJobTracking(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	server.Respond(graphititest.RouteGetJobStatus, http.StatusNotFound, map[string]string{"detail": "Not Found"})
+
+	messages := []graphiti.Message{{Content: "hello", Author: "alice", Timestamp: time.Now().UTC()}}
+	episodes, err := server.Client().AddMessagesAndWait(context.Background(), graphiti.AddMessagesRequest{GroupID: "g", Messages: messages}, 0,
+		graphiti.WithPollInterval(time.Millisecond))
+	if err != nil {
+		t.Fatalf("AddMessagesAndWait: %v", err)
+	}
+	if len(episodes) != 1 {
+		t.Errorf("got %d episodes, want 1", len(episodes))
+	}
+}
+
+func TestWaitForReindex(t *testing.T) {
+	var polls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		status := graphiti.ReindexStatusRunning
+		if atomic.AddInt32(&polls, 1) >= 3 {
+			status = graphiti.ReindexStatusCompleted
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"group_id": "g", "status": "` + status + `"}`))
+	}))
+	defer server.Close()
+
+	client := graphiti.NewClient(server.URL, graphiti.WithHTTPClient(server.Client()))
+	status, err := client.WaitForReindex(context.Background(), "g", graphiti.WithPollInterval(time.Millisecond))
+	if err != nil {
+		t.Fatalf("WaitForReindex: %v", err)
+	}
+	if status.Status != graphiti.ReindexStatusCompleted {
+		t.Errorf("Status = %q, want %q", status.Status, graphiti.ReindexStatusCompleted)
+	}
+
+	atomic.StoreInt32(&polls, -100)
+	_, err = client.WaitForReindex(context.Background(), "g",
+		graphiti.WithPollInterval(time.Millisecond), graphiti.WithMaxAttempts(2))
+	if !errors.Is(err, graphiti.ErrPollTimeout) {
+		t.Errorf("WaitForReindex error = %v, want ErrPollTimeout", err)
+	}
+}
@@ -0,0 +1,70 @@
+package graphiti
+
+import (
+	"net"
+	"time"
+)
+
+// connPool holds the connection pool settings applied to the default transport
+type connPool struct {
+	maxIdleConns    int
+	maxConnsPerHost int
+	keepAlive       time.Duration
+}
+
+// WithMaxIdleConns sets how many idle connections to the server are kept for
+// reuse. The default transport keeps only 2 per host, which forces new
+// connections under concurrent load.
+//
+// The connection pool options configure the client's default transport. They
+// have no effect when WithHTTPClient is used: tune that client's transport instead.
+func WithMaxIdleConns(n int) ClientOption {
+	return func(c *Client) {
+		c.pool.maxIdleConns = n
+	}
+}
+
+// WithMaxConnsPerHost limits the number of connections to the server,
+// including those in use. Requests over the limit wait for a free connection.
+// It has no effect when WithHTTPClient is used.
+func WithMaxConnsPerHost(n int) ClientOption {
+	return func(c *Client) {
+		c.pool.maxConnsPerHost = n
+	}
+}
+
+// WithKeepAlive sets the interval of TCP keep-alive probes on connections to
+// the server; a negative value disables them. It has no effect when
+// WithHTTPClient is used.
+func WithKeepAlive(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.pool.keepAlive = d
+	}
+}
+
+// applyConnPool applies the connection pool settings to the default transport.
+// It runs after all options, so it does not depend on their order.
+func (c *Client) applyConnPool() {
+	if c.customClient || c.pool == (connPool{}) {
+		return
+	}
+	transport := c.transport()
+	if transport == nil {
+		return
+	}
+
+	if c.pool.maxIdleConns > 0 {
+		transport.MaxIdleConns = c.pool.maxIdleConns
+		transport.MaxIdleConnsPerHost = c.pool.maxIdleConns
+	}
+	if c.pool.maxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = c.pool.maxConnsPerHost
+	}
+	if c.pool.keepAlive != 0 {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: c.pool.keepAlive,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+}
@@ -0,0 +1,45 @@
+package graphiti_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+// BenchmarkConcurrentRequests compares the default transport, which keeps only
+// 2 idle connections per host, with a larger pool under concurrent load:
+//
+//	go test -run ^$ -bench ConcurrentRequests
+func BenchmarkConcurrentRequests(b *testing.B) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	benchmarks := []struct {
+		name string
+		opts []graphiti.ClientOption
+	}{
+		{"default", nil},
+		{"WithMaxIdleConns", []graphiti.ClientOption{graphiti.WithMaxIdleConns(64)}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			client := graphiti.NewClient(server.URL, bm.opts...)
+			b.SetParallelism(16)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					if _, err := client.HealthCheck(); err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+		})
+	}
+}
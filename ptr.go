@@ -0,0 +1,19 @@
+package graphiti
+
+// Ptr returns a pointer to v, for the optional pointer fields of requests:
+//
+//	request.GroupID = graphiti.Ptr(groupID)
+//	request.EnableQueryExpansion = graphiti.Ptr(false)
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// SlicePtr returns a pointer to a slice holding the values, for optional
+// slice fields such as SearchQuery.GroupIDs. Without values it returns a
+// pointer to an empty, non-nil slice.
+func SlicePtr[T any](v ...T) *[]T {
+	if v == nil {
+		v = []T{}
+	}
+	return &v
+}
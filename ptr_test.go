@@ -0,0 +1,60 @@
+package graphiti_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+func TestPtr(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		ptr  interface{}
+		want interface{}
+	}{
+		{"string", graphiti.Ptr("group"), "group"},
+		{"empty string", graphiti.Ptr(""), ""},
+		{"bool", graphiti.Ptr(false), false},
+		{"int", graphiti.Ptr(42), 42},
+		{"float64", graphiti.Ptr(0.5), 0.5},
+		{"time", graphiti.Ptr(now), now},
+		{"episode source", graphiti.Ptr(graphiti.EpisodeSourceJSON), graphiti.EpisodeSourceJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reflect.ValueOf(tt.ptr).Elem().Interface(); got != tt.want {
+				t.Errorf("*Ptr(%v) = %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSlicePtr(t *testing.T) {
+	tests := []struct {
+		name string
+		ptr  interface{}
+		want interface{}
+	}{
+		{"strings", graphiti.SlicePtr("g1", "g2"), []string{"g1", "g2"}},
+		{"single string", graphiti.SlicePtr("g1"), []string{"g1"}},
+		{"no strings", graphiti.SlicePtr[string](), []string{}},
+		{"ints", graphiti.SlicePtr(1, 2, 3), []int{1, 2, 3}},
+		{"labels", graphiti.SlicePtr(graphiti.LabelEntity), []string{graphiti.LabelEntity}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reflect.ValueOf(tt.ptr).Elem()
+			if got.IsNil() {
+				t.Fatal("SlicePtr returned a pointer to a nil slice")
+			}
+			if !reflect.DeepEqual(got.Interface(), tt.want) {
+				t.Errorf("*SlicePtr = %v, want %v", got.Interface(), tt.want)
+			}
+		})
+	}
+}
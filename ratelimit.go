@@ -0,0 +1,15 @@
+package graphiti
+
+import (
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit paces requests with a token bucket that refills at rps tokens
+// per second and holds at most burst tokens. All requests made through the
+// client and its OnBehalfOf copies share the budget; each attempt, including
+// retries, waits for a token and gives up when its context is done.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
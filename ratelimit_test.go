@@ -0,0 +1,34 @@
+package graphiti_test
+
+import (
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestRateLimitPacesRequests(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	const (
+		rps   = 20
+		burst = 2
+		calls = 6
+	)
+	client := server.Client(graphiti.WithRateLimit(rps, burst))
+
+	start := time.Now()
+	for i := 0; i < calls; i++ {
+		if _, err := client.HealthCheck(); err != nil {
+			t.Fatalf("call %d: HealthCheck: %v", i, err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	// the burst is served at once, every further call waits for a token
+	if want := time.Duration(calls-burst) * time.Second / rps; elapsed < want {
+		t.Errorf("%d calls took %s, want at least %s", calls, elapsed, want)
+	}
+}
@@ -0,0 +1,35 @@
+package graphiti
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatRecencyWindow(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{"zero", 0, ""},
+		{"negative", -time.Hour, ""},
+		{"sub-minute rounds up", 30 * time.Second, "1m"},
+		{"sub-hour", 45 * time.Minute, "45m"},
+		{"partial minute rounds up", 44*time.Minute + time.Second, "45m"},
+		{"hour and a half", 90 * time.Minute, "90m"},
+		{"whole hours", 6 * time.Hour, "6h"},
+		{"one day stays hours", 24 * time.Hour, "24h"},
+		{"two days", 48 * time.Hour, "2d"},
+		{"one week", 7 * 24 * time.Hour, "7d"},
+		{"day and a half", 36 * time.Hour, "36h"},
+		{"days and minutes", 48*time.Hour + time.Minute, "2881m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatRecencyWindow(tt.d); got != tt.want {
+				t.Errorf("formatRecencyWindow(%v) = %q, want %q", tt.d, got, tt.want)
+			}
+		})
+	}
+}
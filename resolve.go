@@ -0,0 +1,100 @@
+package graphiti
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// resolveMaxCandidates limits the label search used to resolve node names
+const resolveMaxCandidates = 20
+
+// resolveNodeByName finds the single entity node of a group whose name
+// matches name case-insensitively. It returns ErrNodeNotFound if no node
+// matches and ErrAmbiguousNode if several do.
+func (c *Client) resolveNodeByName(ctx context.Context, groupID, name string) (*NodeResult, error) {
+	if name == "" {
+		return nil, invalidf("node name is required")
+	}
+
+	var candidates EntityByLabelSearchResponse
+	if err := c.doContext(ctx, http.MethodPost, "/search/entity-by-label", EntityByLabelSearchRequest{
+		Query:      name,
+		GroupID:    &groupID,
+		NodeLabels: []string{LabelEntity},
+		MaxResults: resolveMaxCandidates,
+	}, &candidates); err != nil {
+		return nil, fmt.Errorf("failed to resolve node %q: %w", name, err)
+	}
+
+	var matches []NodeResult
+	for _, node := range candidates.Nodes {
+		if strings.EqualFold(node.Name, name) {
+			matches = append(matches, node)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("node %q in group %s: %w", name, groupID, ErrNodeNotFound)
+	case 1:
+		return &matches[0], nil
+	default:
+		uuids := make([]string, len(matches))
+		for i, node := range matches {
+			uuids[i] = node.UUID
+		}
+		return nil, fmt.Errorf("node %q in group %s matches %s: %w",
+			name, groupID, strings.Join(uuids, ", "), ErrAmbiguousNode)
+	}
+}
+
+// EntityRelationshipsByName resolves nodeName to the single entity node of
+// the group with that name (case-insensitive) and runs a relationship search
+// centered on it. An empty groupID uses the default group. It returns
+// ErrNodeNotFound or ErrAmbiguousNode if the name does not resolve to exactly
+// one node.
+func (c *Client) EntityRelationshipsByName(ctx context.Context, groupID, nodeName, query string, maxDepth int) (*EntityRelationshipSearchResponse, error) {
+	groupID = c.groupID(groupID)
+	if groupID == "" {
+		return nil, invalidf("group_id is required")
+	}
+	if err := c.requireFeature(ctx, FeatureAdvancedSearch); err != nil {
+		return nil, err
+	}
+
+	center, err := c.resolveNodeByName(ctx, groupID, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	return c.entityRelationshipsSearch(ctx, EntityRelationshipSearchRequest{
+		Query:          query,
+		GroupID:        &groupID,
+		CenterNodeUUID: center.UUID,
+		MaxDepth:       maxDepth,
+	})
+}
+
+// GetMemoryAroundNode resolves nodeName to the single entity node of the group
+// with that name (case-insensitive) and retrieves memory for the messages with
+// facts weighted by their graph distance to that node. An empty groupID uses
+// the default group. It returns ErrNodeNotFound or ErrAmbiguousNode if the
+// name does not resolve to exactly one node.
+func (c *Client) GetMemoryAroundNode(ctx context.Context, groupID, nodeName string, messages []Message, maxFacts int) (*GetMemoryResponse, error) {
+	request := GetMemoryRequest{
+		GroupID:  c.groupID(groupID),
+		MaxFacts: maxFacts,
+		Messages: messages,
+	}
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	center, err := c.resolveNodeByName(ctx, request.GroupID, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	request.CenterNodeUUID = &center.UUID
+	return c.getMemory(ctx, request)
+}
@@ -0,0 +1,71 @@
+package graphiti_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestEntityRelationshipsByNameUsesDefaultGroup(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	var labelGroup, searchGroup, center string
+	server.Handle(graphititest.RouteEntityByLabel, func(w http.ResponseWriter, r *http.Request) {
+		var request graphiti.EntityByLabelSearchRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if request.GroupID != nil {
+			labelGroup = *request.GroupID
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(graphiti.EntityByLabelSearchResponse{
+			Nodes: []graphiti.NodeResult{{UUID: "node-1", Name: "Alice"}},
+		})
+	})
+	server.Handle(graphititest.RouteEntityRelationships, func(w http.ResponseWriter, r *http.Request) {
+		var request graphiti.EntityRelationshipSearchRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if request.GroupID != nil {
+			searchGroup = *request.GroupID
+		}
+		center = request.CenterNodeUUID
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(graphiti.EntityRelationshipSearchResponse{})
+	})
+
+	client := server.Client(graphiti.WithDefaultGroupID("prod"))
+	if _, err := client.EntityRelationshipsByName(context.Background(), "", "alice", "friends", 2); err != nil {
+		t.Fatalf("EntityRelationshipsByName: %v", err)
+	}
+	if labelGroup != "prod" || searchGroup != "prod" {
+		t.Errorf("groups = %q (label search), %q (relationship search), want prod", labelGroup, searchGroup)
+	}
+	if center != "node-1" {
+		t.Errorf("center node = %q, want node-1", center)
+	}
+}
+
+func TestEntityRelationshipsByNameChecksCapabilities(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	server.Respond(graphititest.RouteServerInfo, http.StatusOK, graphiti.ServerInfo{
+		Version:  "test",
+		Features: []string{graphiti.FeatureCommunities},
+	})
+	server.Handle(graphititest.RouteEntityByLabel, func(w http.ResponseWriter, _ *http.Request) {
+		t.Error("label search sent despite the missing feature")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client := server.Client(graphiti.WithCapabilityCheck())
+	_, err := client.EntityRelationshipsByName(context.Background(), "g", "alice", "friends", 2)
+	if !errors.Is(err, graphiti.ErrUnsupported) {
+		t.Errorf("error = %v, want ErrUnsupported", err)
+	}
+}
@@ -0,0 +1,41 @@
+package graphiti
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// headerRecorder holds the headers of the most recent response. It is shared
+// by the views derived from a client.
+type headerRecorder struct {
+	mu     sync.Mutex
+	header http.Header
+}
+
+// record stores the headers of resp
+func (r *headerRecorder) record(resp *http.Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.header = resp.Header.Clone()
+}
+
+// LastResponseHeaders returns a copy of the headers of the most recent
+// response received by the client or its views, including error responses,
+// or nil if none was received yet. Responses served from the cache do not
+// update it. With concurrent calls it belongs to whichever response arrived
+// last; use DoRaw to read the headers of a specific call.
+func (c *Client) LastResponseHeaders() http.Header {
+	c.lastResponse.mu.Lock()
+	defer c.lastResponse.mu.Unlock()
+	return c.lastResponse.header.Clone()
+}
+
+// DoRaw sends a request to path, relative to the base URL, and returns the
+// HTTP response for callers that need its headers or body as is. The request
+// goes through the client's retries, rate limiting, headers and signing; body,
+// if not nil, is sent as JSON. A non-2xx status is returned as an *APIError.
+// The caller must close the response body.
+func (c *Client) DoRaw(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	return c.send(ctx, method, path, body)
+}
@@ -0,0 +1,457 @@
+package graphiti
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+)
+
+// PrimaryType returns the primary entity type of the node. It prefers the
+// server-provided EntityType and falls back to the first label other than the
+// generic "Entity" label, or "Entity" if the node has no other labels.
+func (n NodeResult) PrimaryType() string {
+	if n.EntityType != "" {
+		return n.EntityType
+	}
+
+	for _, label := range n.Labels {
+		if label != LabelEntity {
+			return label
+		}
+	}
+
+	if len(n.Labels) > 0 {
+		return n.Labels[0]
+	}
+
+	return ""
+}
+
+// Result item types used in ScoredItem
+const (
+	ItemTypeEdge      = "edge"
+	ItemTypeNode      = "node"
+	ItemTypeEpisode   = "episode"
+	ItemTypeCommunity = "community"
+)
+
+// ScoredItem represents a single ranked search result of any type.
+// Payload holds the EdgeResult, NodeResult, EpisodeResult or CommunityResult.
+type ScoredItem struct {
+	Type    string      `json:"type"`
+	UUID    string      `json:"uuid"`
+	Score   float64     `json:"score"`
+	Payload interface{} `json:"payload"`
+}
+
+// scoreAt returns the score at index i, or zero if the score is missing
+func scoreAt(scores []float64, i int) float64 {
+	if i < len(scores) {
+		return scores[i]
+	}
+	return 0
+}
+
+func appendEdges(items []ScoredItem, edges []EdgeResult, scores []float64) []ScoredItem {
+	for i, edge := range edges {
+		items = append(items, ScoredItem{Type: ItemTypeEdge, UUID: edge.UUID, Score: scoreAt(scores, i), Payload: edge})
+	}
+	return items
+}
+
+func appendNodes(items []ScoredItem, nodes []NodeResult, scores []float64) []ScoredItem {
+	for i, node := range nodes {
+		items = append(items, ScoredItem{Type: ItemTypeNode, UUID: node.UUID, Score: scoreAt(scores, i), Payload: node})
+	}
+	return items
+}
+
+func appendEpisodes(items []ScoredItem, episodes []EpisodeResult, scores []float64) []ScoredItem {
+	for i, episode := range episodes {
+		items = append(items, ScoredItem{Type: ItemTypeEpisode, UUID: episode.UUID, Score: scoreAt(scores, i), Payload: episode})
+	}
+	return items
+}
+
+func appendCommunities(items []ScoredItem, communities []CommunityResult, scores []float64) []ScoredItem {
+	for i, community := range communities {
+		items = append(items, ScoredItem{Type: ItemTypeCommunity, UUID: community.UUID, Score: scoreAt(scores, i), Payload: community})
+	}
+	return items
+}
+
+// distancesToScores converts graph distances to scores where closer is higher
+func distancesToScores(distances []float64) []float64 {
+	scores := make([]float64, len(distances))
+	for i, d := range distances {
+		scores[i] = 1 / (1 + d)
+	}
+	return scores
+}
+
+// sortScoredItems sorts items by score in descending order, keeping the
+// server order for equal scores
+func sortScoredItems(items []ScoredItem) []ScoredItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Score > items[j].Score
+	})
+	return items
+}
+
+// UnifiedResults returns all results as a single list sorted by score
+func (r *TemporalSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendEdges(nil, r.Edges, r.EdgeScores)
+	items = appendNodes(items, r.Nodes, r.NodeScores)
+	items = appendEpisodes(items, r.Episodes, r.EpisodeScores)
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score.
+// Distances are converted to scores as 1/(1+distance), so closer items rank higher.
+func (r *EntityRelationshipSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendEdges(nil, r.Edges, distancesToScores(r.EdgeDistances))
+	items = appendNodes(items, r.Nodes, distancesToScores(r.NodeDistances))
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score
+func (r *DiverseSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendEdges(nil, r.Edges, r.EdgeMMRScores)
+	items = appendNodes(items, r.Nodes, r.NodeMMRScores)
+	items = appendEpisodes(items, r.Episodes, r.EpisodeScores)
+	items = appendCommunities(items, r.Communities, r.CommunityMMRScores)
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score
+func (r *EpisodeContextSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendEpisodes(nil, r.Episodes, r.RerankerScores)
+	items = appendNodes(items, r.MentionedNodes, r.MentionedNodeScores)
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score.
+// Edges and nodes are scored by their mention counts.
+func (r *SuccessfulToolsSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendEdges(nil, r.Edges, r.EdgeMentionCounts)
+	items = appendNodes(items, r.Nodes, r.NodeMentionCounts)
+	items = appendEpisodes(items, r.Episodes, r.EpisodeScores)
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score
+func (r *RecentContextSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendEdges(nil, r.Edges, r.EdgeScores)
+	items = appendNodes(items, r.Nodes, r.NodeScores)
+	items = appendEpisodes(items, r.Episodes, r.EpisodeScores)
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score
+func (r *EntityByLabelSearchResponse) UnifiedResults() []ScoredItem {
+	items := appendNodes(nil, r.Nodes, r.NodeScores)
+	items = appendEdges(items, r.Edges, r.EdgeScores)
+	return sortScoredItems(items)
+}
+
+// UnifiedResults returns all results as a single list sorted by score
+func (r *CommunitySearchResponse) UnifiedResults() []ScoredItem {
+	return sortScoredItems(appendCommunities(nil, r.Communities, r.CommunityScores))
+}
+
+// TrimByScore returns the leading items whose score is at least minScore.
+// Items must be sorted by score in descending order, as UnifiedResults returns them.
+func TrimByScore(items []ScoredItem, minScore float64) []ScoredItem {
+	for i, item := range items {
+		if item.Score < minScore {
+			return items[:i]
+		}
+	}
+	return items
+}
+
+// SearchResponse is implemented by all advanced search responses and allows
+// handling them uniformly. Methods return nil for result types a response
+// does not contain.
+type SearchResponse interface {
+	EdgeResults() []EdgeResult
+	NodeResults() []NodeResult
+	EpisodeResults() []EpisodeResult
+	CommunityResults() []CommunityResult
+	UnifiedResults() []ScoredItem
+}
+
+var (
+	_ SearchResponse = (*TemporalSearchResponse)(nil)
+	_ SearchResponse = (*EntityRelationshipSearchResponse)(nil)
+	_ SearchResponse = (*DiverseSearchResponse)(nil)
+	_ SearchResponse = (*EpisodeContextSearchResponse)(nil)
+	_ SearchResponse = (*SuccessfulToolsSearchResponse)(nil)
+	_ SearchResponse = (*RecentContextSearchResponse)(nil)
+	_ SearchResponse = (*EntityByLabelSearchResponse)(nil)
+	_ SearchResponse = (*CommunitySearchResponse)(nil)
+)
+
+func (r *TemporalSearchResponse) EdgeResults() []EdgeResult           { return r.Edges }
+func (r *TemporalSearchResponse) NodeResults() []NodeResult           { return r.Nodes }
+func (r *TemporalSearchResponse) EpisodeResults() []EpisodeResult     { return r.Episodes }
+func (r *TemporalSearchResponse) CommunityResults() []CommunityResult { return nil }
+
+func (r *EntityRelationshipSearchResponse) EdgeResults() []EdgeResult           { return r.Edges }
+func (r *EntityRelationshipSearchResponse) NodeResults() []NodeResult           { return r.Nodes }
+func (r *EntityRelationshipSearchResponse) EpisodeResults() []EpisodeResult     { return nil }
+func (r *EntityRelationshipSearchResponse) CommunityResults() []CommunityResult { return nil }
+
+func (r *DiverseSearchResponse) EdgeResults() []EdgeResult           { return r.Edges }
+func (r *DiverseSearchResponse) NodeResults() []NodeResult           { return r.Nodes }
+func (r *DiverseSearchResponse) EpisodeResults() []EpisodeResult     { return r.Episodes }
+func (r *DiverseSearchResponse) CommunityResults() []CommunityResult { return r.Communities }
+
+func (r *EpisodeContextSearchResponse) EdgeResults() []EdgeResult           { return nil }
+func (r *EpisodeContextSearchResponse) NodeResults() []NodeResult           { return r.MentionedNodes }
+func (r *EpisodeContextSearchResponse) EpisodeResults() []EpisodeResult     { return r.Episodes }
+func (r *EpisodeContextSearchResponse) CommunityResults() []CommunityResult { return nil }
+
+func (r *SuccessfulToolsSearchResponse) EdgeResults() []EdgeResult           { return r.Edges }
+func (r *SuccessfulToolsSearchResponse) NodeResults() []NodeResult           { return r.Nodes }
+func (r *SuccessfulToolsSearchResponse) EpisodeResults() []EpisodeResult     { return r.Episodes }
+func (r *SuccessfulToolsSearchResponse) CommunityResults() []CommunityResult { return nil }
+
+func (r *RecentContextSearchResponse) EdgeResults() []EdgeResult           { return r.Edges }
+func (r *RecentContextSearchResponse) NodeResults() []NodeResult           { return r.Nodes }
+func (r *RecentContextSearchResponse) EpisodeResults() []EpisodeResult     { return r.Episodes }
+func (r *RecentContextSearchResponse) CommunityResults() []CommunityResult { return nil }
+
+func (r *EntityByLabelSearchResponse) EdgeResults() []EdgeResult           { return r.Edges }
+func (r *EntityByLabelSearchResponse) NodeResults() []NodeResult           { return r.Nodes }
+func (r *EntityByLabelSearchResponse) EpisodeResults() []EpisodeResult     { return nil }
+func (r *EntityByLabelSearchResponse) CommunityResults() []CommunityResult { return nil }
+
+func (r *CommunitySearchResponse) EdgeResults() []EdgeResult           { return nil }
+func (r *CommunitySearchResponse) NodeResults() []NodeResult           { return nil }
+func (r *CommunitySearchResponse) EpisodeResults() []EpisodeResult     { return nil }
+func (r *CommunitySearchResponse) CommunityResults() []CommunityResult { return r.Communities }
+
+// AsEdge converts the fact to an edge. A bare fact does not carry the
+// endpoints of its edge, so SourceNodeUUID and TargetNodeUUID are empty; use
+// EnrichFacts to fill them in.
+func (f FactResult) AsEdge() EdgeResult {
+	return EdgeResult{
+		UUID:      f.UUID,
+		Name:      f.Name,
+		Fact:      f.Fact,
+		ValidAt:   f.ValidAt,
+		InvalidAt: f.InvalidAt,
+		CreatedAt: f.CreatedAt,
+		ExpiredAt: f.ExpiredAt,
+	}
+}
+
+// FactsAsEdges converts facts to edges with AsEdge, keeping their order
+func FactsAsEdges(facts []FactResult) []EdgeResult {
+	edges := make([]EdgeResult, len(facts))
+	for i, fact := range facts {
+		edges[i] = fact.AsEdge()
+	}
+	return edges
+}
+
+// FilterFactsByRegex returns the facts whose text matches the regular expression pattern
+func FilterFactsByRegex(facts []FactResult, pattern string) ([]FactResult, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fact filter pattern %q: %w", pattern, err)
+	}
+	return filterFacts(facts, re), nil
+}
+
+// postFilter compiles the query's PostFilterRegex, returning nil if it is not set
+func (q SearchQuery) postFilter() (*regexp.Regexp, error) {
+	if q.PostFilterRegex == "" {
+		return nil, nil
+	}
+	filter, err := regexp.Compile(q.PostFilterRegex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid post-filter pattern %q: %w", q.PostFilterRegex, err)
+	}
+	return filter, nil
+}
+
+// filterFacts returns the facts whose text matches re
+func filterFacts(facts []FactResult, re *regexp.Regexp) []FactResult {
+	return filterFactsBy(facts, func(fact FactResult) bool {
+		return re.MatchString(fact.Fact)
+	})
+}
+
+// ValidAt returns the facts that hold at t: those valid from or before t and
+// not invalidated at or before t. A missing ValidAt or InvalidAt is treated
+// as unbounded.
+func (r SearchResults) ValidAt(t time.Time) SearchResults {
+	return SearchResults{Facts: filterFactsBy(r.Facts, func(fact FactResult) bool {
+		return validAt(fact.ValidAt, fact.InvalidAt, t)
+	})}
+}
+
+// NonExpired returns the facts that have not been expired, i.e. superseded in the graph
+func (r SearchResults) NonExpired() SearchResults {
+	return SearchResults{Facts: filterFactsBy(r.Facts, func(fact FactResult) bool {
+		return fact.ExpiredAt == nil
+	})}
+}
+
+// SortByCreatedAt returns the facts sorted by creation time, oldest first.
+// Facts created at the same time keep their order.
+func (r SearchResults) SortByCreatedAt() SearchResults {
+	facts := slices.Clone(r.Facts)
+	slices.SortStableFunc(facts, func(a, b FactResult) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return SearchResults{Facts: facts}
+}
+
+// EdgesByDirection splits the edges by their direction relative to the center
+// node: incoming edges target it and outgoing edges start from it. Self-loops
+// are in both lists; edges further from the center are in neither.
+func (r *EntityRelationshipSearchResponse) EdgesByDirection(centerNodeUUID string) (incoming, outgoing []EdgeResult) {
+	for _, edge := range r.Edges {
+		if edge.TargetNodeUUID == centerNodeUUID {
+			incoming = append(incoming, edge)
+		}
+		if edge.SourceNodeUUID == centerNodeUUID {
+			outgoing = append(outgoing, edge)
+		}
+	}
+	return incoming, outgoing
+}
+
+// EdgesValidAt returns the edges that hold at t, with the semantics of SearchResults.ValidAt
+func EdgesValidAt(edges []EdgeResult, t time.Time) []EdgeResult {
+	return filterEdges(edges, func(edge EdgeResult) bool {
+		return validAt(edge.ValidAt, edge.InvalidAt, t)
+	})
+}
+
+// NonExpiredEdges returns the edges that have not been expired
+func NonExpiredEdges(edges []EdgeResult) []EdgeResult {
+	return filterEdges(edges, func(edge EdgeResult) bool {
+		return edge.ExpiredAt == nil
+	})
+}
+
+// SortEdgesByCreatedAt returns a copy of the edges sorted by creation time, oldest first
+func SortEdgesByCreatedAt(edges []EdgeResult) []EdgeResult {
+	sorted := slices.Clone(edges)
+	slices.SortStableFunc(sorted, func(a, b EdgeResult) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return sorted
+}
+
+// validAt reports whether the validity interval [validAt, invalidAt) contains t
+func validAt(validAt, invalidAt *time.Time, t time.Time) bool {
+	return (validAt == nil || !validAt.After(t)) && (invalidAt == nil || invalidAt.After(t))
+}
+
+// filterFactsBy returns the facts for which keep returns true
+func filterFactsBy(facts []FactResult, keep func(FactResult) bool) []FactResult {
+	filtered := make([]FactResult, 0, len(facts))
+	for _, fact := range facts {
+		if keep(fact) {
+			filtered = append(filtered, fact)
+		}
+	}
+	return filtered
+}
+
+// filterEdges returns the edges for which keep returns true
+func filterEdges(edges []EdgeResult, keep func(EdgeResult) bool) []EdgeResult {
+	filtered := make([]EdgeResult, 0, len(edges))
+	for _, edge := range edges {
+		if keep(edge) {
+			filtered = append(filtered, edge)
+		}
+	}
+	return filtered
+}
+
+// FilterEpisodesBySource returns the episodes created from one of the sources
+func FilterEpisodesBySource(episodes []Episode, sources ...EpisodeSource) []Episode {
+	filtered := make([]Episode, 0, len(episodes))
+	for _, episode := range episodes {
+		if slices.Contains(sources, episode.Source) {
+			filtered = append(filtered, episode)
+		}
+	}
+	return filtered
+}
+
+// FilterEpisodeResultsBySource returns the episode results created from one of the sources
+func FilterEpisodeResultsBySource(episodes []EpisodeResult, sources ...EpisodeSource) []EpisodeResult {
+	filtered := make([]EpisodeResult, 0, len(episodes))
+	for _, episode := range episodes {
+		if slices.Contains(sources, episode.Source) {
+			filtered = append(filtered, episode)
+		}
+	}
+	return filtered
+}
+
+// DedupeNodes returns the nodes with duplicate UUIDs removed, keeping the
+// first occurrence of each
+func DedupeNodes(nodes []NodeResult) []NodeResult {
+	deduped, _ := dedupe(nodes, nil, false, func(node NodeResult) string { return node.UUID })
+	return deduped
+}
+
+// DedupeEdges returns the edges with duplicate UUIDs removed, keeping the
+// first occurrence of each
+func DedupeEdges(edges []EdgeResult) []EdgeResult {
+	deduped, _ := dedupe(edges, nil, false, func(edge EdgeResult) string { return edge.UUID })
+	return deduped
+}
+
+// DedupeNodesWithScores removes nodes with duplicate UUIDs from nodes and the
+// parallel scores slice. Each UUID keeps the position of its first occurrence
+// and the node and score of its highest-scored occurrence. Missing scores
+// count as zero.
+func DedupeNodesWithScores(nodes []NodeResult, scores []float64) ([]NodeResult, []float64) {
+	return dedupe(nodes, scores, true, func(node NodeResult) string { return node.UUID })
+}
+
+// DedupeEdgesWithScores removes edges with duplicate UUIDs from edges and the
+// parallel scores slice, like DedupeNodesWithScores
+func DedupeEdgesWithScores(edges []EdgeResult, scores []float64) ([]EdgeResult, []float64) {
+	return dedupe(edges, scores, true, func(edge EdgeResult) string { return edge.UUID })
+}
+
+// dedupe removes items with duplicate keys. Unless scored, the first
+// occurrence wins; otherwise the highest-scored one replaces it in place.
+func dedupe[T any](items []T, scores []float64, scored bool, key func(T) string) ([]T, []float64) {
+	index := make(map[string]int, len(items))
+	deduped := make([]T, 0, len(items))
+	var dedupedScores []float64
+	if scored {
+		dedupedScores = make([]float64, 0, len(items))
+	}
+
+	for i, item := range items {
+		k := key(item)
+		j, seen := index[k]
+		if !seen {
+			index[k] = len(deduped)
+			deduped = append(deduped, item)
+			if scored {
+				dedupedScores = append(dedupedScores, scoreAt(scores, i))
+			}
+			continue
+		}
+		if scored && scoreAt(scores, i) > dedupedScores[j] {
+			deduped[j] = item
+			dedupedScores[j] = scoreAt(scores, i)
+		}
+	}
+	return deduped, dedupedScores
+}
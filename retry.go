@@ -0,0 +1,144 @@
+package graphiti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRetryDelay caps the backoff delay between retries
+const maxRetryDelay = 30 * time.Second
+
+// retryPolicy controls retries of transient failures
+type retryPolicy struct {
+	maxRetries  int
+	baseDelay   time.Duration
+	retryWrites bool
+}
+
+// WithRetry retries transient failures (connection errors and 502, 503 and 504
+// responses) up to maxRetries times with exponential backoff and jitter,
+// starting at baseDelay and capped at 30 seconds. A zero baseDelay retries
+// immediately. A Retry-After response header takes precedence over the
+// computed delay. Only idempotent requests (GET, HEAD, DELETE and the search
+// and memory queries) are retried unless WithRetryWrites is also set.
+func WithRetry(maxRetries int, baseDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		if baseDelay < 0 {
+			c.setConfigErr(fmt.Errorf("retry base delay must not be negative, got %s", baseDelay))
+			return
+		}
+		if c.retry == nil {
+			c.retry = &retryPolicy{}
+		}
+		c.retry.maxRetries = maxRetries
+		c.retry.baseDelay = baseDelay
+	}
+}
+
+// WithRetryWrites allows WithRetry to retry non-idempotent writes such as
+// AddMessages, which may then be applied more than once
+func WithRetryWrites() ClientOption {
+	return func(c *Client) {
+		if c.retry == nil {
+			c.retry = &retryPolicy{}
+		}
+		c.retry.retryWrites = true
+	}
+}
+
+// shouldRetry reports whether a failed attempt should be retried
+func (p *retryPolicy) shouldRetry(attempt int, method, path string, err error) bool {
+	if p == nil || attempt >= p.maxRetries {
+		return false
+	}
+	if !p.retryWrites && !isIdempotent(method, path) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// transport errors such as connection resets
+	return true
+}
+
+// delay returns the wait before the next attempt, honoring Retry-After
+func (p *retryPolicy) delay(attempt int, err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if d, ok := parseRetryAfter(apiErr.retryAfter); ok {
+			return d
+		}
+	}
+
+	if p.baseDelay <= 0 {
+		return 0
+	}
+	backoff := p.baseDelay << attempt
+	if attempt >= 63 || backoff>>attempt != p.baseDelay || backoff > maxRetryDelay {
+		// the shift overflowed or exceeds the cap
+		backoff = maxRetryDelay
+	}
+
+	// equal jitter: wait between half and the full backoff
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// isIdempotent reports whether a request can be safely repeated
+func isIdempotent(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete, http.MethodPut:
+		return true
+	case http.MethodPost:
+		return strings.HasPrefix(path, "/search") || path == "/get-memory"
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses a Retry-After header in seconds or HTTP date form
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package graphiti_test
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+// flakyHealthCheck makes the health check fail with 503 the given number of
+// times before succeeding, and returns the attempt counter
+func flakyHealthCheck(server *graphititest.MockServer, failures int32) *int32 {
+	var attempts int32
+	server.Handle(graphititest.RouteHealthCheck, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&attempts, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"detail": "unavailable"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status": "healthy"}`))
+	})
+	return &attempts
+}
+
+func TestRetrySucceedsAfterTransientFailures(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	attempts := flakyHealthCheck(server, 2)
+
+	health, err := server.Client(graphiti.WithRetry(3, time.Millisecond)).HealthCheck()
+	if err != nil {
+		t.Fatalf("HealthCheck: %v", err)
+	}
+	if health.Status != "healthy" {
+		t.Errorf("Status = %q, want healthy", health.Status)
+	}
+	if got := atomic.LoadInt32(attempts); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	attempts := flakyHealthCheck(server, 5)
+
+	_, err := server.Client(graphiti.WithRetry(1, time.Millisecond)).HealthCheck()
+	if err == nil {
+		t.Fatal("HealthCheck succeeded, want the last 503")
+	}
+	if got := atomic.LoadInt32(attempts); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+}
+
+func TestRetryZeroBaseDelayDoesNotWait(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+	flakyHealthCheck(server, 2)
+
+	start := time.Now()
+	if _, err := server.Client(graphiti.WithRetry(2, 0)).HealthCheck(); err != nil {
+		t.Fatalf("HealthCheck: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retries with a zero base delay took %s", elapsed)
+	}
+}
+
+func TestRetryRejectsNegativeBaseDelay(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	if _, err := server.Client(graphiti.WithRetry(2, -time.Second)).HealthCheck(); err == nil {
+		t.Fatal("HealthCheck succeeded despite a negative retry base delay")
+	}
+}
+
+func TestRetrySkipsWrites(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	var attempts int32
+	server.Handle(graphititest.RouteAddMessages, func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := server.Client(graphiti.WithRetry(3, time.Millisecond)).AddMessages(graphiti.AddMessagesRequest{
+		GroupID:  "g",
+		Messages: []graphiti.Message{{Content: "hello", Author: "alice", Timestamp: time.Now()}},
+	})
+	if err == nil {
+		t.Fatal("AddMessages succeeded, want the 503")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
@@ -0,0 +1,152 @@
+package graphiti
+
+import "iter"
+
+// ScoredEdge pairs an edge with its score
+type ScoredEdge struct {
+	Edge  EdgeResult
+	Score float64
+}
+
+// ScoredNode pairs a node with its score
+type ScoredNode struct {
+	Node  NodeResult
+	Score float64
+}
+
+// ScoredEpisode pairs an episode with its score
+type ScoredEpisode struct {
+	Episode EpisodeResult
+	Score   float64
+}
+
+// ScoredCommunity pairs a community with its score
+type ScoredCommunity struct {
+	Community CommunityResult
+	Score     float64
+}
+
+// collect gathers the pairs yielded by seq using the given constructor
+func collect[T, S any](seq iter.Seq2[T, float64], pair func(T, float64) S) []S {
+	var result []S
+	for item, score := range seq {
+		result = append(result, pair(item, score))
+	}
+	return result
+}
+
+func scoredEdge(edge EdgeResult, score float64) ScoredEdge {
+	return ScoredEdge{Edge: edge, Score: score}
+}
+
+func scoredNode(node NodeResult, score float64) ScoredNode {
+	return ScoredNode{Node: node, Score: score}
+}
+
+func scoredEpisode(episode EpisodeResult, score float64) ScoredEpisode {
+	return ScoredEpisode{Episode: episode, Score: score}
+}
+
+func scoredCommunity(community CommunityResult, score float64) ScoredCommunity {
+	return ScoredCommunity{Community: community, Score: score}
+}
+
+// ScoredEdges returns edges paired with their scores, as yielded by EdgesWithScores
+func (r *TemporalSearchResponse) ScoredEdges() []ScoredEdge {
+	return collect(r.EdgesWithScores(), scoredEdge)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *TemporalSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredEpisodes returns episodes paired with their scores, as yielded by EpisodesWithScores
+func (r *TemporalSearchResponse) ScoredEpisodes() []ScoredEpisode {
+	return collect(r.EpisodesWithScores(), scoredEpisode)
+}
+
+// ScoredEdges returns edges paired with their scores, as yielded by EdgesWithScores
+func (r *EntityRelationshipSearchResponse) ScoredEdges() []ScoredEdge {
+	return collect(r.EdgesWithScores(), scoredEdge)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *EntityRelationshipSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredEdges returns edges paired with their scores, as yielded by EdgesWithScores
+func (r *DiverseSearchResponse) ScoredEdges() []ScoredEdge {
+	return collect(r.EdgesWithScores(), scoredEdge)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *DiverseSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredEpisodes returns episodes paired with their scores, as yielded by EpisodesWithScores
+func (r *DiverseSearchResponse) ScoredEpisodes() []ScoredEpisode {
+	return collect(r.EpisodesWithScores(), scoredEpisode)
+}
+
+// ScoredCommunities returns communities paired with their scores, as yielded by CommunitiesWithScores
+func (r *DiverseSearchResponse) ScoredCommunities() []ScoredCommunity {
+	return collect(r.CommunitiesWithScores(), scoredCommunity)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *EpisodeContextSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredEpisodes returns episodes paired with their scores, as yielded by EpisodesWithScores
+func (r *EpisodeContextSearchResponse) ScoredEpisodes() []ScoredEpisode {
+	return collect(r.EpisodesWithScores(), scoredEpisode)
+}
+
+// ScoredEdges returns edges paired with their scores, as yielded by EdgesWithScores
+func (r *SuccessfulToolsSearchResponse) ScoredEdges() []ScoredEdge {
+	return collect(r.EdgesWithScores(), scoredEdge)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *SuccessfulToolsSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredEpisodes returns episodes paired with their scores, as yielded by EpisodesWithScores
+func (r *SuccessfulToolsSearchResponse) ScoredEpisodes() []ScoredEpisode {
+	return collect(r.EpisodesWithScores(), scoredEpisode)
+}
+
+// ScoredEdges returns edges paired with their scores, as yielded by EdgesWithScores
+func (r *RecentContextSearchResponse) ScoredEdges() []ScoredEdge {
+	return collect(r.EdgesWithScores(), scoredEdge)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *RecentContextSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredEpisodes returns episodes paired with their scores, as yielded by EpisodesWithScores
+func (r *RecentContextSearchResponse) ScoredEpisodes() []ScoredEpisode {
+	return collect(r.EpisodesWithScores(), scoredEpisode)
+}
+
+// ScoredEdges returns edges paired with their scores, as yielded by EdgesWithScores
+func (r *EntityByLabelSearchResponse) ScoredEdges() []ScoredEdge {
+	return collect(r.EdgesWithScores(), scoredEdge)
+}
+
+// ScoredNodes returns nodes paired with their scores, as yielded by NodesWithScores
+func (r *EntityByLabelSearchResponse) ScoredNodes() []ScoredNode {
+	return collect(r.NodesWithScores(), scoredNode)
+}
+
+// ScoredCommunities returns communities paired with their scores, as yielded by CommunitiesWithScores
+func (r *CommunitySearchResponse) ScoredCommunities() []ScoredCommunity {
+	return collect(r.CommunitiesWithScores(), scoredCommunity)
+}
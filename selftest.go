@@ -0,0 +1,239 @@
+package graphiti
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	selfTestPollInterval = 2 * time.Second
+	selfTestPollAttempts = 30
+	selfTestCleanupTime  = 30 * time.Second
+	// selfTestEpisodeWindow is how many recent episodes are searched for the
+	// self-test episode
+	selfTestEpisodeWindow = 10
+)
+
+// EndpointCheck represents the outcome of a single endpoint probe
+type EndpointCheck struct {
+	Name     string
+	Endpoint string
+	Success  bool
+	Latency  time.Duration
+	Err      error
+}
+
+// SelfTestReport represents the outcome of SelfTest
+type SelfTestReport struct {
+	// GroupID is the generated group the self-test wrote to
+	GroupID string
+	Checks  []EndpointCheck
+}
+
+// Passed reports whether every check succeeded
+func (r *SelfTestReport) Passed() bool {
+	for _, check := range r.Checks {
+		if !check.Success {
+			return false
+		}
+	}
+	return true
+}
+
+// Failed returns the checks that did not succeed
+func (r *SelfTestReport) Failed() []EndpointCheck {
+	var failed []EndpointCheck
+	for _, check := range r.Checks {
+		if !check.Success {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// SelfTest exercises the server end to end: it runs a health check, adds a
+// message, waits for its episode, searches, probes every advanced search and
+// deletes the test data again. The data is written to a fresh group derived
+// from groupID (groupID + "-selftest-" + a random suffix), so groupID itself
+// is never modified. The generated group is deleted even when checks fail.
+// Individual failures are recorded in the report; an error is returned only
+// if ctx is done.
+func (c *Client) SelfTest(ctx context.Context, groupID string) (*SelfTestReport, error) {
+	suffix, err := selfTestSuffix()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate self-test group: %w", err)
+	}
+	groupID = groupID + "-selftest-" + suffix
+	episodeName := "self-test-" + suffix
+
+	report := &SelfTestReport{GroupID: groupID}
+	run := func(name, method, path string, body, result interface{}) bool {
+		start := time.Now()
+		err := c.doContext(ctx, method, path, body, result)
+		report.Checks = append(report.Checks, EndpointCheck{
+			Name:     name,
+			Endpoint: method + " " + path,
+			Success:  err == nil,
+			Latency:  time.Since(start),
+			Err:      err,
+		})
+		return err == nil
+	}
+
+	if !run("HealthCheck", http.MethodGet, "/healthcheck", nil, &HealthCheckResponse{}) {
+		return report, ctx.Err()
+	}
+
+	groupPath := fmt.Sprintf("/group/%s", url.PathEscape(groupID))
+	defer func() {
+		// clean up with a fresh context so cancellation does not leak test data
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), selfTestCleanupTime)
+		defer cancel()
+		start := time.Now()
+		err := c.doContext(cleanupCtx, http.MethodDelete, groupPath, nil, nil)
+		report.Checks = append(report.Checks, EndpointCheck{
+			Name:     "DeleteGroup",
+			Endpoint: http.MethodDelete + " " + groupPath,
+			Success:  err == nil,
+			Latency:  time.Since(start),
+			Err:      err,
+		})
+	}()
+
+	now := time.Now().UTC()
+	added := run("AddMessages", http.MethodPost, "/messages", AddMessagesRequest{
+		GroupID: groupID,
+		Messages: []Message{{
+			Content:           "Graphiti self-test: the scanner host 10.0.0.1 runs OpenSSH",
+			Name:              episodeName,
+			Author:            "graphiti-self-test",
+			Timestamp:         now,
+			SourceDescription: "graphiti-go-client self-test",
+		}},
+	}, &Result{})
+	if added {
+		if err := c.waitSelfTestEpisode(ctx, report, groupID, episodeName); err != nil {
+			return report, err
+		}
+	}
+
+	query := "scanner host OpenSSH"
+	run("Search", http.MethodPost, "/search", SearchQuery{
+		GroupIDs: &[]string{groupID},
+		Query:    query,
+		MaxFacts: 5,
+	}, &SearchResults{})
+	run("TemporalWindowSearch", http.MethodPost, "/search/temporal-window", TemporalSearchRequest{
+		Query:      query,
+		GroupID:    &groupID,
+		TimeStart:  now.Add(-time.Hour),
+		TimeEnd:    now.Add(time.Hour),
+		MaxResults: 5,
+	}, &TemporalSearchResponse{})
+	run("DiverseResultsSearch", http.MethodPost, "/search/diverse-results", DiverseSearchRequest{
+		Query:      query,
+		GroupID:    &groupID,
+		MaxResults: 5,
+	}, &DiverseSearchResponse{})
+	run("EpisodeContextSearch", http.MethodPost, "/search/episode-context", EpisodeContextSearchRequest{
+		Query:      query,
+		GroupID:    &groupID,
+		MaxResults: 5,
+	}, &EpisodeContextSearchResponse{})
+	run("SuccessfulToolsSearch", http.MethodPost, "/search/successful-tools", SuccessfulToolsSearchRequest{
+		Query:      query,
+		GroupID:    &groupID,
+		MaxResults: 5,
+	}, &SuccessfulToolsSearchResponse{})
+	run("RecentContextSearch", http.MethodPost, "/search/recent-context", RecentContextSearchRequest{
+		Query:         query,
+		GroupID:       &groupID,
+		RecencyWindow: "1h",
+		MaxResults:    5,
+	}, &RecentContextSearchResponse{})
+	run("EntityByLabelSearch", http.MethodPost, "/search/entity-by-label", EntityByLabelSearchRequest{
+		Query:      query,
+		GroupID:    &groupID,
+		NodeLabels: []string{LabelEntity},
+		MaxResults: 5,
+	}, &EntityByLabelSearchResponse{})
+
+	var labeled EntityByLabelSearchResponse
+	if err := c.doContext(ctx, http.MethodPost, "/search/entity-by-label", EntityByLabelSearchRequest{
+		Query:      query,
+		GroupID:    &groupID,
+		NodeLabels: []string{LabelEntity},
+		MaxResults: 1,
+	}, &labeled); err == nil && len(labeled.Nodes) > 0 {
+		run("EntityRelationshipsSearch", http.MethodPost, "/search/entity-relationships", EntityRelationshipSearchRequest{
+			Query:          query,
+			GroupID:        &groupID,
+			CenterNodeUUID: labeled.Nodes[0].UUID,
+			MaxDepth:       1,
+			MaxResults:     5,
+		}, &EntityRelationshipSearchResponse{})
+	}
+
+	return report, ctx.Err()
+}
+
+// selfTestSuffix returns a random suffix for the self-test group and episode
+func selfTestSuffix() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// waitSelfTestEpisode polls until the self-test message has been processed
+// into an episode called name and records the outcome in the report
+func (c *Client) waitSelfTestEpisode(ctx context.Context, report *SelfTestReport, groupID, name string) error {
+	path := fmt.Sprintf("/episodes/%s?last_n=%d", url.PathEscape(groupID), selfTestEpisodeWindow)
+	start := time.Now()
+	check := EndpointCheck{Name: "GetEpisodes", Endpoint: http.MethodGet + " " + path}
+
+	for attempt := 1; attempt <= selfTestPollAttempts; attempt++ {
+		var episodes []Episode
+		if err := c.doContext(withoutCache(ctx), http.MethodGet, path, nil, &episodes); err != nil {
+			check.Err = err
+			break
+		}
+		if hasEpisodeNamed(episodes, name) {
+			check.Success = true
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			check.Latency = time.Since(start)
+			check.Err = ctx.Err()
+			report.Checks = append(report.Checks, check)
+			return ctx.Err()
+		case <-time.After(selfTestPollInterval):
+		}
+	}
+
+	if !check.Success && check.Err == nil {
+		check.Err = fmt.Errorf("episode was not processed after %d attempts", selfTestPollAttempts)
+	}
+	check.Latency = time.Since(start)
+	report.Checks = append(report.Checks, check)
+
+	return nil
+}
+
+// hasEpisodeNamed reports whether episodes contains one called name
+func hasEpisodeNamed(episodes []Episode, name string) bool {
+	for _, episode := range episodes {
+		if episode.Name == name {
+			return true
+		}
+	}
+	return false
+}
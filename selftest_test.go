@@ -0,0 +1,62 @@
+package graphiti_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+	"github.com/vxcontrol/graphiti-go-client/graphititest"
+)
+
+func TestSelfTestUsesGeneratedGroup(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	existing := graphititest.NewEpisode("existing", "prod", "keep me", time.Now().UTC())
+	server.AddEpisodes(existing)
+
+	report, err := server.Client().SelfTest(context.Background(), "prod")
+	if err != nil {
+		t.Fatalf("SelfTest: %v", err)
+	}
+	if !report.Passed() {
+		t.Fatalf("SelfTest failed checks: %+v", report.Failed())
+	}
+	if !strings.HasPrefix(report.GroupID, "prod-selftest-") {
+		t.Errorf("GroupID = %q, want prod-selftest- prefix", report.GroupID)
+	}
+
+	if episodes := server.Episodes("prod"); len(episodes) != 1 || episodes[0].UUID != existing.UUID {
+		t.Errorf("episodes of the caller's group = %+v, want only %q", episodes, existing.UUID)
+	}
+	if episodes := server.Episodes(report.GroupID); len(episodes) != 0 {
+		t.Errorf("self-test group was not cleaned up: %+v", episodes)
+	}
+}
+
+func TestSelfTestIgnoresOtherEpisodes(t *testing.T) {
+	server := graphititest.NewMockServer()
+	defer server.Close()
+
+	// drop the self-test message so only an unrelated episode is ever listed
+	server.Handle(graphititest.RouteGetEpisodes, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]graphiti.Episode{
+			graphititest.NewEpisode("older", r.PathValue("group_id"), "unrelated", time.Now().UTC()),
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	report, _ := server.Client().SelfTest(ctx, "prod")
+	for _, check := range report.Checks {
+		if check.Name == "GetEpisodes" && check.Success {
+			t.Fatal("GetEpisodes succeeded on an episode the self-test did not create")
+		}
+	}
+}
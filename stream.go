@@ -0,0 +1,118 @@
+package graphiti
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// ndjsonContentType is the media type of newline-delimited JSON streams
+const ndjsonContentType = "application/x-ndjson"
+
+// StreamMemory retrieves memory like GetMemory but decodes the facts one at a
+// time and passes each to fn, so large responses are never held in memory at
+// once. It asks for a newline-delimited JSON stream of facts, which is read as
+// it arrives; servers that answer with the regular response have the facts
+// array decoded incrementally instead. Streaming stops at the first error
+// returned by fn, which StreamMemory returns.
+func (c *Client) StreamMemory(ctx context.Context, request GetMemoryRequest, fn func(FactResult) error) error {
+	request.GroupID = c.groupID(request.GroupID)
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	ctx, cancel := c.requestContext(withRequestHeader(ctx, "Accept", ndjsonContentType+", application/json"))
+	defer cancel()
+
+	resp, err := c.send(ctx, http.MethodPost, "/get-memory", request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	dec := c.newDecoder(resp.Body)
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType == ndjsonContentType {
+		return streamFactLines(dec, fn)
+	}
+	return streamFactsArray(dec, c.strictDecoding, fn)
+}
+
+// streamFactLines passes each fact of a newline-delimited JSON stream to fn
+func streamFactLines(dec *json.Decoder, fn func(FactResult) error) error {
+	for {
+		var fact FactResult
+		if err := dec.Decode(&fact); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("failed to decode fact: %w", err)
+		}
+		if err := fn(fact); err != nil {
+			return err
+		}
+	}
+}
+
+// streamFactsArray passes each element of the facts array of a
+// GetMemoryResponse to fn, skipping the other fields unless strict is set
+func streamFactsArray(dec *json.Decoder, strict bool, fn func(FactResult) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		if token != "facts" && strict {
+			return fmt.Errorf("failed to decode response: unknown field %q", token)
+		}
+		if token != "facts" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			continue
+		}
+
+		token, err = dec.Token()
+		if err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		if token == nil {
+			continue // "facts": null
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("failed to decode response: expected facts array, got %v", token)
+		}
+		for dec.More() {
+			var fact FactResult
+			if err := dec.Decode(&fact); err != nil {
+				return fmt.Errorf("failed to decode fact: %w", err)
+			}
+			if err := fn(fact); err != nil {
+				return err
+			}
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expectDelim reads the next token and checks that it is the delimiter want
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("failed to decode response: expected %q, got %v", want, token)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package graphiti
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// timeFormat controls how time.Time values in request bodies are sent
+type timeFormat struct {
+	layout string
+	utc    bool
+}
+
+// WithUTCTimestamps converts every time in request bodies, such as message
+// timestamps and temporal search windows, to UTC before sending it, for
+// servers that reject UTC offsets. Times are sent in RFC 3339 format unless
+// WithTimeFormat sets another layout. Responses are parsed as before.
+func WithUTCTimestamps() ClientOption {
+	return func(c *Client) {
+		c.timeFormat.utc = true
+	}
+}
+
+// WithTimeFormat sends every time in request bodies formatted with layout,
+// e.g. "2006-01-02T15:04:05Z07:00" or time.DateTime. Combine it with
+// WithUTCTimestamps to also convert the times to UTC. Responses are parsed as
+// before.
+func WithTimeFormat(layout string) ClientOption {
+	return func(c *Client) {
+		c.timeFormat.layout = layout
+	}
+}
+
+// enabled reports whether request times need rewriting
+func (f timeFormat) enabled() bool {
+	return f.utc || f.layout != ""
+}
+
+func (f timeFormat) format(t time.Time) interface{} {
+	if f.utc {
+		t = t.UTC()
+	}
+	if f.layout == "" {
+		return t // marshaled as RFC 3339
+	}
+	return t.Format(f.layout)
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textType      = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// apply returns a value that marshals like body, following the encoding/json
+// rules for field names, omitempty, the string option and embedded structs,
+// but with every time.Time formatted according to f. Values implementing
+// json.Marshaler or encoding.TextMarshaler are sent as they marshal
+// themselves, so times inside them keep their format. Unlike encoding/json,
+// conflicting fields at the same depth of embedding are not dropped: the
+// first one wins.
+func (f timeFormat) apply(body interface{}) interface{} {
+	if !f.enabled() || body == nil {
+		return body
+	}
+	return f.value(reflect.ValueOf(body))
+}
+
+func (f timeFormat) value(v reflect.Value) interface{} {
+	switch {
+	case v.Type() == timeType:
+		return f.format(v.Interface().(time.Time))
+	case v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return f.value(v.Elem())
+	case v.Type().Implements(marshalerType) || v.Type().Implements(textType):
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		f.fields(v, fields, make(map[string]int), 0)
+		return fields
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Interface() // []byte is sent as base64
+		}
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = f.value(v.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return v.Interface()
+		}
+		entries := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entries[iter.Key().String()] = f.value(iter.Value())
+		}
+		return entries
+	default:
+		return v.Interface()
+	}
+}
+
+// fields adds the JSON fields of struct v to fields, flattening untagged
+// embedded structs. depths records the embedding depth each name was added
+// at, so that a shallower field hides a deeper one as in encoding/json.
+func (f timeFormat) fields(v reflect.Value, fields map[string]interface{}, depths map[string]int, depth int) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		value := v.Field(i)
+
+		if field.Anonymous && name == "" {
+			if value.Kind() == reflect.Pointer {
+				if value.IsNil() {
+					continue
+				}
+				value = value.Elem()
+			}
+			if value.Kind() == reflect.Struct {
+				f.fields(value, fields, depths, depth+1)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if d, ok := depths[name]; ok && d <= depth {
+			continue
+		}
+		depths[name] = depth
+		if hasOption(opts, "omitempty") && isEmptyValue(value) {
+			delete(fields, name)
+			continue
+		}
+		if hasOption(opts, "string") {
+			if quoted, ok := quoteValue(value); ok {
+				fields[name] = quoted
+				continue
+			}
+		}
+		fields[name] = f.value(value)
+	}
+}
+
+func hasOption(opts, option string) bool {
+	return strings.Contains(","+opts+",", ","+option+",")
+}
+
+// quoteValue encodes v as a JSON string, as the string option of
+// encoding/json does for booleans, numbers and strings
+func quoteValue(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+	}
+	return "", false
+}
+
+// isEmptyValue reports whether omitempty drops v, as in encoding/json
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
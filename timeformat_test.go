@@ -0,0 +1,131 @@
+package graphiti
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type timeFormatBase struct {
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+}
+
+type timeFormatMeta struct {
+	Name  string `json:"name"`
+	Owner string
+}
+
+type timeFormatCustom struct {
+	At time.Time
+}
+
+func (timeFormatCustom) MarshalJSON() ([]byte, error) {
+	return []byte(`"custom"`), nil
+}
+
+type timeFormatSample struct {
+	timeFormatBase
+	*timeFormatMeta
+	Name     string   `json:"name"`
+	Count    int64    `json:"count,string"`
+	Enabled  bool     `json:"enabled,string"`
+	Ratio    *float64 `json:"ratio,string,omitempty"`
+	Note     string   `json:"note,omitempty"`
+	Skipped  string   `json:"-"`
+	Untagged string
+	Custom   timeFormatCustom  `json:"custom"`
+	Times    []time.Time       `json:"times"`
+	ByName   map[string]string `json:"by_name,omitempty"`
+	internal string
+}
+
+// TestTimeFormatMatchesEncodingJSON checks that rewriting a body whose times
+// are already in UTC leaves its JSON unchanged
+func TestTimeFormatMatchesEncodingJSON(t *testing.T) {
+	ratio := 0.5
+	at := time.Date(2025, 1, 15, 7, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		body interface{}
+	}{
+		{"embedded and tagged", timeFormatSample{
+			timeFormatBase: timeFormatBase{ID: "base", Name: "hidden", Created: at},
+			timeFormatMeta: &timeFormatMeta{Name: "hidden too", Owner: "meta"},
+			Name:           "outer",
+			Count:          42,
+			Enabled:        true,
+			Ratio:          &ratio,
+			Skipped:        "not sent",
+			Untagged:       "default name",
+			Custom:         timeFormatCustom{At: at},
+			Times:          []time.Time{at},
+			internal:       "unexported",
+		}},
+		{"nil embedded pointer and empty fields", timeFormatSample{Name: "outer"}},
+		{"pointer to struct", &timeFormatBase{ID: "p", Created: at}},
+	}
+
+	f := timeFormat{utc: true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := json.Marshal(tt.body)
+			if err != nil {
+				t.Fatalf("json.Marshal: %v", err)
+			}
+			got, err := json.Marshal(f.apply(tt.body))
+			if err != nil {
+				t.Fatalf("json.Marshal(apply): %v", err)
+			}
+			var gotFields, wantFields interface{}
+			_ = json.Unmarshal(got, &gotFields)
+			_ = json.Unmarshal(want, &wantFields)
+			if !reflect.DeepEqual(gotFields, wantFields) {
+				t.Errorf("apply changed the body:\n got %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestTimeFormatRewritesTimes(t *testing.T) {
+	at := time.Date(2025, 1, 15, 9, 30, 0, 0, time.FixedZone("EET", 2*60*60))
+	body := timeFormatSample{
+		timeFormatBase: timeFormatBase{Created: at},
+		Times:          []time.Time{at},
+		Custom:         timeFormatCustom{At: at},
+	}
+
+	tests := []struct {
+		name string
+		f    timeFormat
+		want string
+	}{
+		{"utc", timeFormat{utc: true}, "2025-01-15T07:30:00Z"},
+		{"layout", timeFormat{layout: time.DateTime}, "2025-01-15 09:30:00"},
+		{"utc and layout", timeFormat{utc: true, layout: time.DateTime}, "2025-01-15 07:30:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.f.apply(body))
+			if err != nil {
+				t.Fatalf("json.Marshal: %v", err)
+			}
+			var got struct {
+				Created string   `json:"created"`
+				Times   []string `json:"times"`
+				Custom  string   `json:"custom"`
+			}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+			if got.Created != tt.want || len(got.Times) != 1 || got.Times[0] != tt.want {
+				t.Errorf("times = %q, %q, want %q", got.Created, got.Times, tt.want)
+			}
+			if got.Custom != "custom" {
+				t.Errorf("custom = %q, want the value's own encoding", got.Custom)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package graphiti
+
+import "time"
+
+// LastN returns the time window covering the last d up to now, in UTC
+func LastN(d time.Duration) TimeWindow {
+	now := time.Now().UTC()
+	return TimeWindow{Start: now.Add(-d), End: now}
+}
+
+// Between returns the time window between start and end, in UTC
+func Between(start, end time.Time) TimeWindow {
+	return TimeWindow{Start: start.UTC(), End: end.UTC()}
+}
+
+// Today returns the time window covering the current UTC day
+func Today() TimeWindow {
+	start := startOfDayUTC(time.Now())
+	return TimeWindow{Start: start, End: start.AddDate(0, 0, 1)}
+}
+
+// Yesterday returns the time window covering the previous UTC day
+func Yesterday() TimeWindow {
+	end := startOfDayUTC(time.Now())
+	return TimeWindow{Start: end.AddDate(0, 0, -1), End: end}
+}
+
+// startOfDayUTC returns midnight UTC of the day containing t
+func startOfDayUTC(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,113 @@
+package graphiti
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// WithTLSConfig sets the TLS configuration of the client's transport, e.g. for
+// mutual TLS with client certificates. Like the other TLS options it must come
+// after WithHTTPClient and has no effect on a custom transport that is not an
+// *http.Transport. A client passed to WithHTTPClient is never modified: the
+// TLS options apply to a copy of it with a cloned transport. Likewise config
+// is copied, so it can be shared between clients. It composes with WithTimeout.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		if transport := c.transport(); transport != nil {
+			// later TLS options modify the config, so keep the caller's untouched
+			transport.TLSClientConfig = config.Clone()
+		}
+	}
+}
+
+// WithRootCAFile trusts the PEM-encoded CA certificates in path in addition to
+// the system roots. If the file cannot be loaded, every request fails with the
+// load error.
+func WithRootCAFile(path string) ClientOption {
+	return func(c *Client) {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			c.setConfigErr(fmt.Errorf("failed to read root CA file: %w", err))
+			return
+		}
+
+		config := c.tlsConfig()
+		if config == nil {
+			return
+		}
+		if config.RootCAs == nil {
+			if config.RootCAs, err = x509.SystemCertPool(); err != nil {
+				config.RootCAs = x509.NewCertPool()
+			}
+		}
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			c.setConfigErr(fmt.Errorf("failed to load root CA file %s: no PEM certificates found", path))
+		}
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the server's certificate.
+// It is meant for development against self-signed servers only.
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *Client) {
+		if config := c.tlsConfig(); config != nil {
+			config.InsecureSkipVerify = true
+		}
+	}
+}
+
+// transport returns the client's *http.Transport, installing a clone of the
+// default transport if none is set. It returns nil for custom round trippers.
+// The first call on a client set with WithHTTPClient replaces it with a
+// shallow copy using a clone of its transport, so the caller's client and
+// transport, which may be shared such as http.DefaultClient, stay untouched.
+func (c *Client) transport() *http.Transport {
+	if c.customClient && !c.ownTransport {
+		var clone *http.Transport
+		switch transport := c.httpClient.Transport.(type) {
+		case nil:
+			clone = http.DefaultTransport.(*http.Transport).Clone()
+		case *http.Transport:
+			clone = transport.Clone()
+		default:
+			return nil
+		}
+		httpClient := *c.httpClient
+		httpClient.Transport = clone
+		c.httpClient = &httpClient
+		c.ownTransport = true
+	}
+
+	switch transport := c.httpClient.Transport.(type) {
+	case nil:
+		clone := http.DefaultTransport.(*http.Transport).Clone()
+		c.httpClient.Transport = clone
+		return clone
+	case *http.Transport:
+		return transport
+	default:
+		return nil
+	}
+}
+
+// tlsConfig returns the transport's TLS configuration, creating it if needed
+func (c *Client) tlsConfig() *tls.Config {
+	transport := c.transport()
+	if transport == nil {
+		return nil
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	return transport.TLSClientConfig
+}
+
+// setConfigErr records the first option error, which fails every request
+func (c *Client) setConfigErr(err error) {
+	if c.configErr == nil {
+		c.configErr = err
+	}
+}
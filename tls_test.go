@@ -0,0 +1,55 @@
+package graphiti_test
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+func TestTLSOptionsDoNotModifyCustomClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "healthy"}`))
+	}))
+	defer server.Close()
+
+	transport := &http.Transport{}
+	custom := &http.Client{Transport: transport}
+	shared := &http.Client{}
+
+	for name, httpClient := range map[string]*http.Client{"custom transport": custom, "nil transport": shared} {
+		client := graphiti.NewClient(server.URL,
+			graphiti.WithHTTPClient(httpClient),
+			graphiti.WithInsecureSkipVerify())
+		if _, err := client.HealthCheck(); err != nil {
+			t.Errorf("%s: HealthCheck: %v", name, err)
+		}
+	}
+
+	if config := transport.TLSClientConfig; config != nil && config.InsecureSkipVerify {
+		t.Error("the caller's transport was made insecure")
+	}
+	if custom.Transport != transport {
+		t.Error("transport of the caller's client was replaced")
+	}
+	if shared.Transport != nil {
+		t.Error("a transport was installed on the caller's client")
+	}
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil && config.InsecureSkipVerify {
+		t.Error("http.DefaultTransport was made insecure")
+	}
+}
+
+func TestTLSConfigIsCopied(t *testing.T) {
+	shared := &tls.Config{MinVersion: tls.VersionTLS12}
+	graphiti.NewClient("https://localhost:8443",
+		graphiti.WithTLSConfig(shared),
+		graphiti.WithInsecureSkipVerify())
+
+	if shared.InsecureSkipVerify {
+		t.Error("WithInsecureSkipVerify modified the config passed to WithTLSConfig")
+	}
+}
@@ -0,0 +1,141 @@
+package graphiti
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// TraceInfo holds the timings of a single HTTP request attempt. Phases that
+// did not happen, such as DNS, connect and TLS on a reused connection, are
+// zero. Path is the request path including its query, and StatusCode is zero
+// when no response was received.
+type TraceInfo struct {
+	Method          string
+	Path            string
+	StatusCode      int
+	ConnReused      bool
+	DNSLookup       time.Duration
+	Connect         time.Duration
+	TLSHandshake    time.Duration
+	TimeToFirstByte time.Duration
+	Total           time.Duration
+	Err             error
+}
+
+// WithHTTPTrace collects connection timings for every request attempt,
+// including retries, and passes them to fn once the attempt completes.
+// TimeToFirstByte is measured from the start of the attempt, so comparing it
+// with the network phases tells whether slowness is network or server-side.
+// fn must be safe for concurrent use.
+func WithHTTPTrace(fn func(TraceInfo)) ClientOption {
+	return func(c *Client) {
+		c.trace = fn
+	}
+}
+
+// requestTrace collects the timings of one request attempt
+type requestTrace struct {
+	fn    func(TraceInfo)
+	start time.Time
+
+	mu           sync.Mutex
+	reused       bool
+	dnsStart     time.Time
+	dnsDone      time.Time
+	connectStart time.Time
+	connectDone  time.Time
+	tlsStart     time.Time
+	tlsDone      time.Time
+	firstByte    time.Time
+}
+
+// startTrace attaches a client trace to ctx when tracing is enabled. The
+// returned trace is nil otherwise.
+func (c *Client) startTrace(ctx context.Context) (context.Context, *requestTrace) {
+	if c.trace == nil {
+		return ctx, nil
+	}
+
+	t := &requestTrace{fn: c.trace, start: time.Now()}
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.set(func() { t.reused = info.Reused })
+		},
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.set(func() { t.dnsStart = time.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.set(func() { t.dnsDone = time.Now() })
+		},
+		ConnectStart: func(string, string) {
+			// with several addresses only the first dial attempt is timed
+			t.set(func() {
+				if t.connectStart.IsZero() {
+					t.connectStart = time.Now()
+				}
+			})
+		},
+		ConnectDone: func(string, string, error) {
+			t.set(func() { t.connectDone = time.Now() })
+		},
+		TLSHandshakeStart: func() {
+			t.set(func() { t.tlsStart = time.Now() })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.set(func() { t.tlsDone = time.Now() })
+		},
+		GotFirstResponseByte: func() {
+			t.set(func() { t.firstByte = time.Now() })
+		},
+	}), t
+}
+
+func (t *requestTrace) set(fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fn()
+}
+
+// finish reports the collected timings; it is a no-op on a nil trace
+func (t *requestTrace) finish(method, path string, resp *http.Response, err error) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	info := TraceInfo{
+		Method:          method,
+		Path:            path,
+		ConnReused:      t.reused,
+		DNSLookup:       between(t.dnsStart, t.dnsDone),
+		Connect:         between(t.connectStart, t.connectDone),
+		TLSHandshake:    between(t.tlsStart, t.tlsDone),
+		TimeToFirstByte: between(t.start, t.firstByte),
+		Total:           time.Since(t.start),
+		Err:             err,
+	}
+	t.mu.Unlock()
+
+	var apiErr *APIError
+	switch {
+	case resp != nil:
+		info.StatusCode = resp.StatusCode
+	case errors.As(err, &apiErr):
+		info.StatusCode = apiErr.StatusCode
+	}
+
+	t.fn(info)
+}
+
+// between returns the time from start to end, or zero if either is unset
+func between(start, end time.Time) time.Duration {
+	if start.IsZero() || end.IsZero() {
+		return 0
+	}
+	return end.Sub(start)
+}
@@ -9,14 +9,26 @@ type Observation struct {
 	Time    time.Time `json:"time"`
 }
 
-// Message represents a message in the system
+// EpisodeSource classifies an episode by the kind of content it was created from
+type EpisodeSource string
+
+// Episode sources supported by the server
+const (
+	EpisodeSourceMessage EpisodeSource = "message"
+	EpisodeSourceText    EpisodeSource = "text"
+	EpisodeSourceJSON    EpisodeSource = "json"
+)
+
+// Message represents a message in the system.
+// Source defaults to EpisodeSourceMessage on the server when empty.
 type Message struct {
-	Content           string    `json:"content"`
-	UUID              *string   `json:"uuid,omitempty"`
-	Name              string    `json:"name,omitempty"`
-	Author            string    `json:"author"`
-	Timestamp         time.Time `json:"timestamp"`
-	SourceDescription string    `json:"source_description,omitempty"`
+	Content           string        `json:"content"`
+	UUID              *string       `json:"uuid,omitempty"`
+	Name              string        `json:"name,omitempty"`
+	Author            string        `json:"author"`
+	Timestamp         time.Time     `json:"timestamp"`
+	Source            EpisodeSource `json:"source,omitempty"`
+	SourceDescription string        `json:"source_description,omitempty"`
 }
 
 // Result represents a generic result response
@@ -25,20 +37,60 @@ type Result struct {
 	Success bool   `json:"success"`
 }
 
-// HealthCheckResponse represents the health check response
-type HealthCheckResponse struct {
-	Status string `json:"status"`
+// AddMessagesResponse represents the response to AddMessages. JobID
+// identifies the asynchronous ingestion job for GetJobStatus and WaitForJob;
+// it is empty when the server does not report jobs.
+type AddMessagesResponse struct {
+	Result
+	JobID string `json:"job_id,omitempty"`
 }
 
-// SearchQuery represents a search query request
+// HealthCheckResponse represents the health check response.
+// Version is empty when the server does not report it.
+type HealthCheckResponse struct {
+	Status  string `json:"status"`
+	Version string `json:"version,omitempty"`
+}
+
+// Search methods for SearchQuery.SearchMethods
+const (
+	SearchMethodBM25             = "bm25"
+	SearchMethodCosineSimilarity = "cosine_similarity"
+	SearchMethodBFS              = "bfs"
+)
+
+// Rerankers for SearchQuery.Reranker
+const (
+	RerankerRRF          = "rrf"
+	RerankerMMR          = "mmr"
+	RerankerCrossEncoder = "cross_encoder"
+	RerankerNodeDistance = "node_distance"
+)
+
+// SearchQuery represents a search query request.
+// SearchMethods, Reranker and CenterNodeUUID tune retrieval and are only sent
+// when set; CenterNodeUUID is used by graph-distance reranking.
+// EnableQueryExpansion toggles server-side query expansion; nil follows the server default.
+// PostFilterRegex, when set, keeps only facts whose text matches it; it is applied client-side.
+// Fields requests a projection of FactResult JSON fields; "uuid" is always returned
+// and omitted fields are left at their zero values. FieldWeights tunes relevance
+// per field (e.g. "content", "summary"); unset fields are weighted equally.
 type SearchQuery struct {
-	GroupIDs    *[]string    `json:"group_ids,omitempty"`
-	Query       string       `json:"query"`
-	MaxFacts    int          `json:"max_facts,omitempty"`
-	Observation *Observation `json:"observation,omitempty"`
-}
-
-// FactResult represents a fact result from the graph
+	GroupIDs             *[]string          `json:"group_ids,omitempty"`
+	Query                string             `json:"query"`
+	MaxFacts             int                `json:"max_facts,omitempty"`
+	SearchMethods        []string           `json:"search_methods,omitempty"`
+	Reranker             string             `json:"reranker,omitempty"`
+	CenterNodeUUID       *string            `json:"center_node_uuid,omitempty"`
+	Fields               []string           `json:"fields,omitempty"`
+	FieldWeights         map[string]float64 `json:"field_weights,omitempty"`
+	EnableQueryExpansion *bool              `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation       `json:"observation,omitempty"`
+	PostFilterRegex      string             `json:"-"`
+}
+
+// FactResult represents a fact result from the graph.
+// When SearchQuery.Fields is set, only UUID and the requested fields are populated.
 type FactResult struct {
 	UUID      string     `json:"uuid"`
 	Name      string     `json:"name"`
@@ -68,20 +120,86 @@ type GetMemoryResponse struct {
 	Facts []FactResult `json:"facts"`
 }
 
-// AddMessagesRequest represents a request to add messages
+// AddMessagesRequest represents a request to add messages.
+// IdempotencyKey, when set, is sent as the Idempotency-Key header so the server
+// can deduplicate resent requests; it is reused across retries.
+// EntityTypes, when set, guides extraction towards custom entity types keyed
+// by their label.
 type AddMessagesRequest struct {
-	GroupID     string       `json:"group_id"`
-	Messages    []Message    `json:"messages"`
-	Observation *Observation `json:"observation,omitempty"`
-}
+	GroupID        string                `json:"group_id"`
+	Messages       []Message             `json:"messages"`
+	EntityTypes    map[string]EntityType `json:"entity_types,omitempty"`
+	Observation    *Observation          `json:"observation,omitempty"`
+	IdempotencyKey string                `json:"-"`
+}
+
+// EntityType describes a custom entity type for extraction, the HTTP
+// counterpart of the Pydantic models passed as entity_types to Graphiti's
+// add_episode. The description tells the server what the type represents and
+// each field becomes an attribute of the extracted nodes, keyed by field name:
+//
+//	{"CVE": {"description": "A published vulnerability",
+//	         "fields": {"cvss": {"type": "number", "description": "CVSS v3 base score"}}}}
+type EntityType struct {
+	Description string                     `json:"description"`
+	Fields      map[string]EntityTypeField `json:"fields,omitempty"`
+}
+
+// EntityTypeField describes an attribute of a custom entity type
+type EntityTypeField struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+}
+
+// Attribute types for EntityTypeField.Type, named after JSON Schema types
+const (
+	FieldTypeString  = "string"
+	FieldTypeInteger = "integer"
+	FieldTypeNumber  = "number"
+	FieldTypeBoolean = "boolean"
+)
 
 // AddEntityNodeRequest represents a request to add an entity node
 type AddEntityNodeRequest struct {
-	UUID        string       `json:"uuid"`
-	GroupID     string       `json:"group_id"`
-	Name        string       `json:"name"`
-	Summary     string       `json:"summary,omitempty"`
-	Observation *Observation `json:"observation,omitempty"`
+	UUID        string                 `json:"uuid"`
+	GroupID     string                 `json:"group_id"`
+	Name        string                 `json:"name"`
+	Summary     string                 `json:"summary,omitempty"`
+	Labels      []string               `json:"labels,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Observation *Observation           `json:"observation,omitempty"`
+}
+
+// UpdateEntityNodeRequest represents a request to update an existing entity node.
+// Empty fields are left unchanged.
+type UpdateEntityNodeRequest struct {
+	UUID        string                 `json:"-"`
+	Name        string                 `json:"name,omitempty"`
+	Summary     string                 `json:"summary,omitempty"`
+	Labels      []string               `json:"labels,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Observation *Observation           `json:"observation,omitempty"`
+}
+
+// MergeNodesRequest represents a request to fold duplicate entity nodes into
+// a primary node. The duplicates' edges are moved to the primary node and the
+// duplicates are deleted.
+type MergeNodesRequest struct {
+	PrimaryUUID    string       `json:"primary_uuid"`
+	DuplicateUUIDs []string     `json:"duplicate_uuids"`
+	Observation    *Observation `json:"observation,omitempty"`
+}
+
+// AddEntityEdgeRequest represents a request to add an entity edge (fact)
+// between two existing nodes
+type AddEntityEdgeRequest struct {
+	UUID           string       `json:"uuid"`
+	GroupID        string       `json:"group_id"`
+	SourceNodeUUID string       `json:"source_node_uuid"`
+	TargetNodeUUID string       `json:"target_node_uuid"`
+	Fact           string       `json:"fact"`
+	ValidAt        *time.Time   `json:"valid_at,omitempty"`
+	Observation    *Observation `json:"observation,omitempty"`
 }
 
 // EntityNode represents an entity node in the graph
@@ -101,13 +219,91 @@ type Episode struct {
 	GroupID           string                 `json:"group_id"`
 	Name              string                 `json:"name"`
 	Content           string                 `json:"content"`
-	Source            string                 `json:"source"`
+	Source            EpisodeSource          `json:"source"`
 	SourceDescription string                 `json:"source_description,omitempty"`
 	CreatedAt         time.Time              `json:"created_at"`
 	ValidAt           time.Time              `json:"valid_at"`
 	Metadata          map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// GroupInfo represents a group known to the server.
+// Counts are zero and Description and Metadata are empty when the server
+// does not report them.
+type GroupInfo struct {
+	GroupID      string                 `json:"group_id"`
+	Description  string                 `json:"description,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	EpisodeCount int                    `json:"episode_count,omitempty"`
+	NodeCount    int                    `json:"node_count,omitempty"`
+	EdgeCount    int                    `json:"edge_count,omitempty"`
+}
+
+// EpisodePageOptions represents options for paging through episodes.
+// Cursor takes precedence over Offset when both are set.
+type EpisodePageOptions struct {
+	Limit  int
+	Offset int
+	Cursor string
+	Start  *time.Time
+	End    *time.Time
+}
+
+// EpisodeFilter narrows the episodes returned by GetEpisodesFiltered.
+// Empty fields do not filter.
+type EpisodeFilter struct {
+	LastN  int
+	Author string
+	Name   string
+}
+
+// EpisodePage represents a page of episodes
+type EpisodePage struct {
+	Episodes   []Episode `json:"episodes"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+	HasMore    bool      `json:"has_more"`
+}
+
+// Reindex statuses reported by the server
+const (
+	ReindexStatusPending   = "pending"
+	ReindexStatusRunning   = "running"
+	ReindexStatusCompleted = "completed"
+	ReindexStatusFailed    = "failed"
+)
+
+// ReindexStatus represents the status of a group reindex
+type ReindexStatus struct {
+	GroupID     string     `json:"group_id"`
+	Status      string     `json:"status"`
+	Message     string     `json:"message,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// Ingestion job statuses reported by the server
+const (
+	JobStatusQueued    = "queued"
+	JobStatusRunning   = "running"
+	JobStatusCompleted = "completed"
+	JobStatusFailed    = "failed"
+)
+
+// JobStatus represents the status of an asynchronous ingestion job
+type JobStatus struct {
+	ID        string     `json:"id"`
+	GroupID   string     `json:"group_id"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	Attempts  int        `json:"attempts,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// Age returns how long ago the job was created
+func (j JobStatus) Age() time.Duration {
+	return time.Since(j.CreatedAt)
+}
+
 // Advanced Search Types
 
 // NodeResult represents a node result from search
@@ -115,6 +311,7 @@ type NodeResult struct {
 	UUID       string                 `json:"uuid"`
 	Name       string                 `json:"name"`
 	Labels     []string               `json:"labels"`
+	EntityType string                 `json:"entity_type,omitempty"`
 	Summary    string                 `json:"summary"`
 	CreatedAt  time.Time              `json:"created_at"`
 	Attributes map[string]interface{} `json:"attributes,omitempty"`
@@ -135,17 +332,18 @@ type EdgeResult struct {
 
 // EpisodeResult represents an episode result from search
 type EpisodeResult struct {
-	UUID              string    `json:"uuid"`
-	Content           string    `json:"content"`
-	Source            string    `json:"source"`
-	SourceDescription string    `json:"source_description"`
-	CreatedAt         time.Time `json:"created_at"`
-	ValidAt           time.Time `json:"valid_at"`
+	UUID              string        `json:"uuid"`
+	Content           string        `json:"content"`
+	Source            EpisodeSource `json:"source"`
+	SourceDescription string        `json:"source_description"`
+	CreatedAt         time.Time     `json:"created_at"`
+	ValidAt           time.Time     `json:"valid_at"`
 }
 
 // CommunityResult represents a community result from search
 type CommunityResult struct {
 	UUID      string    `json:"uuid"`
+	GroupID   string    `json:"group_id,omitempty"`
 	Name      string    `json:"name"`
 	Summary   string    `json:"summary"`
 	CreatedAt time.Time `json:"created_at"`
@@ -157,14 +355,20 @@ type TimeWindow struct {
 	End   time.Time `json:"end"`
 }
 
+// The advanced search requests take either a single GroupID or several
+// GroupIDs. GroupIDs takes precedence: when it is set, GroupID is ignored and
+// only group_ids is sent.
+
 // TemporalSearchRequest represents a temporal window search request
 type TemporalSearchRequest struct {
-	Query       string       `json:"query"`
-	GroupID     *string      `json:"group_id,omitempty"`
-	TimeStart   time.Time    `json:"time_start"`
-	TimeEnd     time.Time    `json:"time_end"`
-	MaxResults  int          `json:"max_results,omitempty"`
-	Observation *Observation `json:"observation,omitempty"`
+	Query                string       `json:"query"`
+	GroupID              *string      `json:"group_id,omitempty"`
+	GroupIDs             *[]string    `json:"group_ids,omitempty"`
+	TimeStart            time.Time    `json:"time_start"`
+	TimeEnd              time.Time    `json:"time_end"`
+	MaxResults           int          `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool        `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation `json:"observation,omitempty"`
 }
 
 // TemporalSearchResponse represents a temporal window search response
@@ -178,16 +382,31 @@ type TemporalSearchResponse struct {
 	TimeWindow    TimeWindow      `json:"time_window"`
 }
 
-// EntityRelationshipSearchRequest represents an entity relationships search request
+// EdgeDirection restricts relationship searches to edges leaving or entering the center node
+type EdgeDirection string
+
+// Edge directions accepted by EntityRelationshipsSearch
+const (
+	DirectionIn   EdgeDirection = "in"
+	DirectionOut  EdgeDirection = "out"
+	DirectionBoth EdgeDirection = "both"
+)
+
+// EntityRelationshipSearchRequest represents an entity relationships search request.
+// Direction restricts the edges to those entering or leaving the center node;
+// empty follows the server default of both directions.
 type EntityRelationshipSearchRequest struct {
-	Query          string       `json:"query"`
-	GroupID        *string      `json:"group_id,omitempty"`
-	CenterNodeUUID string       `json:"center_node_uuid"`
-	MaxDepth       int          `json:"max_depth,omitempty"`
-	NodeLabels     *[]string    `json:"node_labels,omitempty"`
-	EdgeTypes      *[]string    `json:"edge_types,omitempty"`
-	MaxResults     int          `json:"max_results,omitempty"`
-	Observation    *Observation `json:"observation,omitempty"`
+	Query                string        `json:"query"`
+	GroupID              *string       `json:"group_id,omitempty"`
+	GroupIDs             *[]string     `json:"group_ids,omitempty"`
+	CenterNodeUUID       string        `json:"center_node_uuid"`
+	MaxDepth             int           `json:"max_depth,omitempty"`
+	NodeLabels           *[]string     `json:"node_labels,omitempty"`
+	EdgeTypes            *[]string     `json:"edge_types,omitempty"`
+	Direction            EdgeDirection `json:"direction,omitempty"`
+	MaxResults           int           `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool         `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation  `json:"observation,omitempty"`
 }
 
 // EntityRelationshipSearchResponse represents an entity relationships search response
@@ -199,13 +418,25 @@ type EntityRelationshipSearchResponse struct {
 	CenterNode    *NodeResult  `json:"center_node,omitempty"`
 }
 
+// DiversityLevel controls how strongly diverse search penalizes redundant results
+type DiversityLevel string
+
+// Diversity levels accepted by DiverseResultsSearch
+const (
+	DiversityLow    DiversityLevel = "low"
+	DiversityMedium DiversityLevel = "medium"
+	DiversityHigh   DiversityLevel = "high"
+)
+
 // DiverseSearchRequest represents a diverse results search request
 type DiverseSearchRequest struct {
-	Query          string       `json:"query"`
-	GroupID        *string      `json:"group_id,omitempty"`
-	DiversityLevel string       `json:"diversity_level,omitempty"`
-	MaxResults     int          `json:"max_results,omitempty"`
-	Observation    *Observation `json:"observation,omitempty"`
+	Query                string         `json:"query"`
+	GroupID              *string        `json:"group_id,omitempty"`
+	GroupIDs             *[]string      `json:"group_ids,omitempty"`
+	DiversityLevel       DiversityLevel `json:"diversity_level,omitempty"`
+	MaxResults           int            `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool          `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation   `json:"observation,omitempty"`
 }
 
 // DiverseSearchResponse represents a diverse results search response
@@ -222,10 +453,12 @@ type DiverseSearchResponse struct {
 
 // EpisodeContextSearchRequest represents an episode context search request
 type EpisodeContextSearchRequest struct {
-	Query       string       `json:"query"`
-	GroupID     *string      `json:"group_id,omitempty"`
-	MaxResults  int          `json:"max_results,omitempty"`
-	Observation *Observation `json:"observation,omitempty"`
+	Query                string       `json:"query"`
+	GroupID              *string      `json:"group_id,omitempty"`
+	GroupIDs             *[]string    `json:"group_ids,omitempty"`
+	MaxResults           int          `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool        `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation `json:"observation,omitempty"`
 }
 
 // EpisodeContextSearchResponse represents an episode context search response
@@ -238,11 +471,13 @@ type EpisodeContextSearchResponse struct {
 
 // SuccessfulToolsSearchRequest represents a successful tools search request
 type SuccessfulToolsSearchRequest struct {
-	Query       string       `json:"query"`
-	GroupID     *string      `json:"group_id,omitempty"`
-	MinMentions int          `json:"min_mentions,omitempty"`
-	MaxResults  int          `json:"max_results,omitempty"`
-	Observation *Observation `json:"observation,omitempty"`
+	Query                string       `json:"query"`
+	GroupID              *string      `json:"group_id,omitempty"`
+	GroupIDs             *[]string    `json:"group_ids,omitempty"`
+	MinMentions          int          `json:"min_mentions,omitempty"`
+	MaxResults           int          `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool        `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation `json:"observation,omitempty"`
 }
 
 // SuccessfulToolsSearchResponse represents a successful tools search response
@@ -255,13 +490,19 @@ type SuccessfulToolsSearchResponse struct {
 	EpisodeScores     []float64       `json:"episode_scores"`
 }
 
-// RecentContextSearchRequest represents a recent context search request
+// RecentContextSearchRequest represents a recent context search request.
+// RecencyDuration takes precedence over RecencyWindow when set. The window
+// ends at ReferenceTime when set, otherwise at the server's current time.
 type RecentContextSearchRequest struct {
-	Query         string       `json:"query"`
-	GroupID       *string      `json:"group_id,omitempty"`
-	RecencyWindow string       `json:"recency_window,omitempty"`
-	MaxResults    int          `json:"max_results,omitempty"`
-	Observation   *Observation `json:"observation,omitempty"`
+	Query                string        `json:"query"`
+	GroupID              *string       `json:"group_id,omitempty"`
+	GroupIDs             *[]string     `json:"group_ids,omitempty"`
+	RecencyWindow        string        `json:"recency_window,omitempty"`
+	RecencyDuration      time.Duration `json:"-"`
+	ReferenceTime        *time.Time    `json:"reference_time,omitempty"`
+	MaxResults           int           `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool         `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation  `json:"observation,omitempty"`
 }
 
 // RecentContextSearchResponse represents a recent context search response
@@ -277,12 +518,14 @@ type RecentContextSearchResponse struct {
 
 // EntityByLabelSearchRequest represents an entity by label search request
 type EntityByLabelSearchRequest struct {
-	Query       string       `json:"query"`
-	GroupID     *string      `json:"group_id,omitempty"`
-	NodeLabels  []string     `json:"node_labels"`
-	EdgeTypes   *[]string    `json:"edge_types,omitempty"`
-	MaxResults  int          `json:"max_results,omitempty"`
-	Observation *Observation `json:"observation,omitempty"`
+	Query                string       `json:"query"`
+	GroupID              *string      `json:"group_id,omitempty"`
+	GroupIDs             *[]string    `json:"group_ids,omitempty"`
+	NodeLabels           []string     `json:"node_labels"`
+	EdgeTypes            *[]string    `json:"edge_types,omitempty"`
+	MaxResults           int          `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool        `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation `json:"observation,omitempty"`
 }
 
 // EntityByLabelSearchResponse represents an entity by label search response
@@ -292,3 +535,19 @@ type EntityByLabelSearchResponse struct {
 	Edges      []EdgeResult `json:"edges"`
 	EdgeScores []float64    `json:"edge_scores"`
 }
+
+// CommunitySearchRequest represents a community search request
+type CommunitySearchRequest struct {
+	Query                string       `json:"query"`
+	GroupID              *string      `json:"group_id,omitempty"`
+	GroupIDs             *[]string    `json:"group_ids,omitempty"`
+	MaxResults           int          `json:"max_results,omitempty"`
+	EnableQueryExpansion *bool        `json:"enable_query_expansion,omitempty"`
+	Observation          *Observation `json:"observation,omitempty"`
+}
+
+// CommunitySearchResponse represents a community search response
+type CommunitySearchResponse struct {
+	Communities     []CommunityResult `json:"communities"`
+	CommunityScores []float64         `json:"community_scores"`
+}
@@ -0,0 +1,95 @@
+package graphiti_test
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	graphiti "github.com/vxcontrol/graphiti-go-client"
+)
+
+func TestObservationRoundTrip(t *testing.T) {
+	data, err := os.ReadFile("testdata/observation_requests.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	var fixtures map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatalf("decode fixture: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		request interface{}
+	}{
+		{"add_messages", &graphiti.AddMessagesRequest{}},
+		{"search", &graphiti.SearchQuery{}},
+		{"get_memory", &graphiti.GetMemoryRequest{}},
+		{"add_entity_node", &graphiti.AddEntityNodeRequest{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, ok := fixtures[tt.name]
+			if !ok {
+				t.Fatalf("fixture %q missing", tt.name)
+			}
+			if err := json.Unmarshal(raw, tt.request); err != nil {
+				t.Fatalf("unmarshal: %v", err)
+			}
+			encoded, err := json.Marshal(tt.request)
+			if err != nil {
+				t.Fatalf("marshal: %v", err)
+			}
+
+			var want, got interface{}
+			_ = json.Unmarshal(raw, &want)
+			_ = json.Unmarshal(encoded, &got)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("round trip changed the request:\n got %s\nwant %s", encoded, raw)
+			}
+		})
+	}
+}
+
+func TestObservationFields(t *testing.T) {
+	var request graphiti.AddMessagesRequest
+	data, err := os.ReadFile("testdata/observation_requests.json")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	var fixtures struct {
+		AddMessages json.RawMessage `json:"add_messages"`
+	}
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatalf("decode fixture: %v", err)
+	}
+	if err := json.Unmarshal(fixtures.AddMessages, &request); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	obs := request.Observation
+	if obs == nil {
+		t.Fatal("Observation not decoded")
+	}
+	if obs.ID != "obs-7f3a" || obs.TraceID != "trace-9c1e" {
+		t.Errorf("Observation = %+v, want id obs-7f3a and trace_id trace-9c1e", obs)
+	}
+	want := time.Date(2025, 1, 15, 5, 0, 1, 123456789, time.UTC)
+	if !obs.Time.Equal(want) {
+		t.Errorf("Time = %v, want %v", obs.Time, want)
+	}
+}
+
+func TestObservationOmittedWhenNil(t *testing.T) {
+	encoded, err := json.Marshal(graphiti.SearchQuery{Query: "open ports"})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var fields map[string]interface{}
+	_ = json.Unmarshal(encoded, &fields)
+	if _, ok := fields["observation"]; ok {
+		t.Errorf("nil observation sent: %s", encoded)
+	}
+}
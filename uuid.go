@@ -0,0 +1,35 @@
+package graphiti
+
+import (
+	"crypto/sha1"
+	"fmt"
+)
+
+// namespaceURL is the RFC 4122 namespace for URL names
+var namespaceURL = [16]byte{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+// entityNodeNamespace is the namespace of the UUIDs returned by DeterministicUUID
+var entityNodeNamespace = uuidV5(namespaceURL, "https://github.com/vxcontrol/graphiti-go-client/entity-node")
+
+// DeterministicUUID returns a name-based (version 5) UUID for the entity node
+// called name in groupID. The same group and name, compared exactly, always
+// yield the same UUID, so re-importing an entity with AddEntityNode updates
+// the existing node instead of creating a duplicate. This relies on the
+// server treating a repeated node UUID as an upsert.
+func DeterministicUUID(groupID, name string) string {
+	id := uuidV5(entityNodeNamespace, groupID+"\x00"+name)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16])
+}
+
+// uuidV5 returns the RFC 4122 version 5 UUID of name in namespace
+func uuidV5(namespace [16]byte, name string) [16]byte {
+	h := sha1.New()
+	h.Write(namespace[:])
+	h.Write([]byte(name))
+
+	var id [16]byte
+	copy(id[:], h.Sum(nil))
+	id[6] = id[6]&0x0f | 0x50 // version 5
+	id[8] = id[8]&0x3f | 0x80 // RFC 4122 variant
+	return id
+}
@@ -0,0 +1,246 @@
+package graphiti
+
+import "fmt"
+
+// diversityLevels holds the valid diversity levels
+var diversityLevels = map[DiversityLevel]bool{DiversityLow: true, DiversityMedium: true, DiversityHigh: true}
+
+// episodeSources holds the valid episode sources
+var episodeSources = map[EpisodeSource]bool{EpisodeSourceMessage: true, EpisodeSourceText: true, EpisodeSourceJSON: true}
+
+// edgeDirections holds the valid edge directions
+var edgeDirections = map[EdgeDirection]bool{DirectionIn: true, DirectionOut: true, DirectionBoth: true}
+
+// fieldTypes holds the valid entity type attribute types
+var fieldTypes = map[string]bool{FieldTypeString: true, FieldTypeInteger: true, FieldTypeNumber: true, FieldTypeBoolean: true}
+
+// reservedFields holds the node attributes entity type fields must not shadow,
+// which the server rejects
+var reservedFields = map[string]bool{
+	"uuid": true, "name": true, "group_id": true, "labels": true,
+	"created_at": true, "summary": true, "attributes": true, "name_embedding": true,
+}
+
+// invalidf returns an error wrapping ErrInvalidRequest
+func invalidf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{ErrInvalidRequest}, args...)...)
+}
+
+func validateMaxResults(name string, value int) error {
+	if value < 0 {
+		return invalidf("%s must not be negative", name)
+	}
+	return nil
+}
+
+// Validate checks the search query before it is sent
+func (q SearchQuery) Validate() error {
+	if q.Query == "" {
+		return invalidf("query is required")
+	}
+	for field, weight := range q.FieldWeights {
+		if weight < 0 {
+			return invalidf("weight %v for field %q must not be negative", weight, field)
+		}
+	}
+	return validateMaxResults("max_facts", q.MaxFacts)
+}
+
+// Validate checks the get memory request before it is sent
+func (r GetMemoryRequest) Validate() error {
+	if r.GroupID == "" {
+		return invalidf("group_id is required")
+	}
+	if len(r.Messages) == 0 {
+		return invalidf("messages are required")
+	}
+	return validateMaxResults("max_facts", r.MaxFacts)
+}
+
+// Validate checks the add messages request before it is sent
+func (r AddMessagesRequest) Validate() error {
+	if r.GroupID == "" {
+		return invalidf("group_id is required")
+	}
+	if len(r.Messages) == 0 {
+		return invalidf("messages are required")
+	}
+	for i, msg := range r.Messages {
+		if msg.Content == "" {
+			return invalidf("messages[%d].content is required", i)
+		}
+		if msg.Author == "" {
+			return invalidf("messages[%d].author is required", i)
+		}
+		if msg.Source != "" && !episodeSources[msg.Source] {
+			return invalidf("messages[%d].source must be one of message, text or json, got %q", i, msg.Source)
+		}
+	}
+	for label, entityType := range r.EntityTypes {
+		if label == "" {
+			return invalidf("entity_types label is required")
+		}
+		for name, field := range entityType.Fields {
+			if reservedFields[name] {
+				return invalidf("entity_types[%q].fields[%q] is a reserved node attribute", label, name)
+			}
+			if !fieldTypes[field.Type] {
+				return invalidf("entity_types[%q].fields[%q].type must be one of string, integer, number or boolean, got %q",
+					label, name, field.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks the add entity node request before it is sent
+func (r AddEntityNodeRequest) Validate() error {
+	if r.UUID == "" {
+		return invalidf("uuid is required")
+	}
+	if r.GroupID == "" {
+		return invalidf("group_id is required")
+	}
+	if r.Name == "" {
+		return invalidf("name is required")
+	}
+	return nil
+}
+
+// Validate checks the update entity node request before it is sent
+func (r UpdateEntityNodeRequest) Validate() error {
+	if r.UUID == "" {
+		return invalidf("uuid is required")
+	}
+	return nil
+}
+
+// Validate checks the merge nodes request before it is sent
+func (r MergeNodesRequest) Validate() error {
+	if r.PrimaryUUID == "" {
+		return invalidf("primary_uuid is required")
+	}
+	if len(r.DuplicateUUIDs) == 0 {
+		return invalidf("duplicate_uuids must not be empty")
+	}
+	for i, uuid := range r.DuplicateUUIDs {
+		if uuid == "" {
+			return invalidf("duplicate_uuids[%d] is required", i)
+		}
+		if uuid == r.PrimaryUUID {
+			return invalidf("duplicate_uuids[%d] must differ from primary_uuid", i)
+		}
+	}
+	return nil
+}
+
+// Validate checks the add entity edge request before it is sent
+func (r AddEntityEdgeRequest) Validate() error {
+	if r.UUID == "" {
+		return invalidf("uuid is required")
+	}
+	if r.GroupID == "" {
+		return invalidf("group_id is required")
+	}
+	if r.SourceNodeUUID == "" {
+		return invalidf("source_node_uuid is required")
+	}
+	if r.TargetNodeUUID == "" {
+		return invalidf("target_node_uuid is required")
+	}
+	if r.Fact == "" {
+		return invalidf("fact is required")
+	}
+	return nil
+}
+
+// Validate checks the temporal search request before it is sent
+func (r TemporalSearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	if r.TimeStart.IsZero() {
+		return invalidf("time_start is required")
+	}
+	if r.TimeEnd.IsZero() {
+		return invalidf("time_end is required")
+	}
+	if !r.TimeStart.Before(r.TimeEnd) {
+		return invalidf("time_start must be before time_end")
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the entity relationships search request before it is sent
+func (r EntityRelationshipSearchRequest) Validate() error {
+	if r.CenterNodeUUID == "" {
+		return invalidf("center_node_uuid is required")
+	}
+	if r.MaxDepth < 0 {
+		return invalidf("max_depth must not be negative")
+	}
+	if r.Direction != "" && !edgeDirections[r.Direction] {
+		return invalidf("direction must be one of in, out or both, got %q", r.Direction)
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the diverse search request before it is sent
+func (r DiverseSearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	if r.DiversityLevel != "" && !diversityLevels[r.DiversityLevel] {
+		return invalidf("diversity_level must be one of low, medium or high, got %q", r.DiversityLevel)
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the episode context search request before it is sent
+func (r EpisodeContextSearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the successful tools search request before it is sent
+func (r SuccessfulToolsSearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	if r.MinMentions < 0 {
+		return invalidf("min_mentions must not be negative")
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the recent context search request before it is sent
+func (r RecentContextSearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	if r.RecencyDuration < 0 {
+		return invalidf("recency duration must not be negative")
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the entity by label search request before it is sent
+func (r EntityByLabelSearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	if len(r.NodeLabels) == 0 {
+		return invalidf("node_labels must not be empty")
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
+
+// Validate checks the community search request before it is sent
+func (r CommunitySearchRequest) Validate() error {
+	if r.Query == "" {
+		return invalidf("query is required")
+	}
+	return validateMaxResults("max_results", r.MaxResults)
+}
@@ -0,0 +1,96 @@
+package graphiti
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteGraphML writes the nodes and edges of an export as GraphML, e.g. for
+// Gephi. Nodes carry their name, labels and summary as attributes, edges
+// their name and fact.
+func WriteGraphML(export *GraphExport, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(xml.Header)
+	bw.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	for _, key := range []struct{ id, domain, name string }{
+		{"n_name", "node", "name"},
+		{"n_labels", "node", "labels"},
+		{"n_summary", "node", "summary"},
+		{"e_name", "edge", "name"},
+		{"e_fact", "edge", "fact"},
+	} {
+		fmt.Fprintf(bw, "  <key id=%q for=%q attr.name=%q attr.type=\"string\"/>\n", key.id, key.domain, key.name)
+	}
+
+	bw.WriteString(`  <graph id="` + xmlEscape(export.GroupID) + `" edgedefault="directed">` + "\n")
+	for _, node := range export.Nodes {
+		bw.WriteString(`    <node id="` + xmlEscape(node.UUID) + `">` + "\n")
+		writeGraphMLData(bw, "n_name", node.Name)
+		writeGraphMLData(bw, "n_labels", strings.Join(node.Labels, ","))
+		writeGraphMLData(bw, "n_summary", node.Summary)
+		bw.WriteString("    </node>\n")
+	}
+	for _, edge := range export.Edges {
+		bw.WriteString(`    <edge id="` + xmlEscape(edge.UUID) + `" source="` + xmlEscape(edge.SourceNodeUUID) +
+			`" target="` + xmlEscape(edge.TargetNodeUUID) + `">` + "\n")
+		writeGraphMLData(bw, "e_name", edge.Name)
+		writeGraphMLData(bw, "e_fact", edge.Fact)
+		bw.WriteString("    </edge>\n")
+	}
+	bw.WriteString("  </graph>\n</graphml>\n")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write GraphML: %w", err)
+	}
+	return nil
+}
+
+// writeGraphMLData writes a data element unless the value is empty
+func writeGraphMLData(w *bufio.Writer, key, value string) {
+	if value == "" {
+		return
+	}
+	w.WriteString(`      <data key="` + key + `">` + xmlEscape(value) + "</data>\n")
+}
+
+// xmlEscape escapes s for use in XML text and attribute values
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// WriteDOT writes the nodes and edges of an export in Graphviz DOT format.
+// Nodes are labeled with their name, edges with their fact; node labels and
+// summaries are kept as attributes.
+func WriteDOT(export *GraphExport, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("digraph " + dotQuote(export.GroupID) + " {\n")
+	for _, node := range export.Nodes {
+		fmt.Fprintf(bw, "  %s [label=%s, labels=%s, summary=%s];\n",
+			dotQuote(node.UUID), dotQuote(node.Name), dotQuote(strings.Join(node.Labels, ",")), dotQuote(node.Summary))
+	}
+	for _, edge := range export.Edges {
+		fmt.Fprintf(bw, "  %s -> %s [label=%s, name=%s];\n",
+			dotQuote(edge.SourceNodeUUID), dotQuote(edge.TargetNodeUUID), dotQuote(edge.Fact), dotQuote(edge.Name))
+	}
+	bw.WriteString("}\n")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write DOT: %w", err)
+	}
+	return nil
+}
+
+// dotEscaper escapes the characters that are special in DOT quoted strings
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// dotQuote returns s as a DOT quoted string
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}